@@ -191,13 +191,17 @@ func insertSample2() {
 		payload = append(payload, genre)
 	}
 
-	result, err = query.Use(model.GenreModel()).BulkInsert(payload)
+	written, err := query.Use(model.GenreModel()).BulkInsert(payload)
 
 	if nil != err {
 		fmt.Println(err)
 	}
 
-	fmt.Println(result)
+	fmt.Println(written.RowsAffected)
+
+	for _, genre := range payload {
+		fmt.Println(genre.ID)
+	}
 }
 
 func updateSample() {
@@ -217,14 +221,14 @@ func updateSample() {
 
 	v.Name = fmt.Sprintf("Hello World #%d", id)
 
-	isUpdated, err := query.Use(v).Update()
+	result, err := query.Use(v).Update()
 
 	if nil != err {
 		fmt.Println(err)
 		return
 	}
 
-	fmt.Println(isUpdated)
+	fmt.Println(result.RowsAffected)
 }
 
 func deleteSample() {
@@ -239,9 +243,14 @@ func deleteSample() {
 
 	v := genre.(*model.Genre)
 
-	isDeleted, err := query.Use(v).Delete()
+	result, err := query.Use(v).Delete()
+
+	if nil != err {
+		fmt.Println(err)
+		return
+	}
 
-	fmt.Println(isDeleted)
+	fmt.Println(result.RowsAffected)
 }
 
 func selectSample() {
@@ -504,8 +513,8 @@ func paginateStmt(query *goloquent.Query, m goloquent.IModel) {
 		return
 	}
 
-	fmt.Printf("PAGINATE (Page #%d, Limit %d) - Total Data : %d - Statement\n", currentPage, data["total"], limit)
-	for i, v := range data["data"].([]*model.Genre) {
+	fmt.Printf("PAGINATE (Page #%d of %d, Limit %d) - Total Data : %d - Statement\n", data.CurrentPage, data.LastPage, data.PerPage, data.Total)
+	for i, v := range data.Data.([]*model.Genre) {
 		fmt.Printf("Genre #%02d\n", i+1)
 		fmt.Println("==========")
 		fmt.Printf("ID   : %d\n", v.ID)
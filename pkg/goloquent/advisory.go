@@ -0,0 +1,163 @@
+package goloquent
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"hash/fnv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrAdvisoryLockNotAcquired is returned when a try advisory lock finds the key locked by another session
+var ErrAdvisoryLockNotAcquired = errors.New("advisory lock is held by another session")
+
+// ErrAdvisoryLockNotHeld is returned when an advisory lock is unlocked by a session that does not hold it
+var ErrAdvisoryLockNotHeld = errors.New("advisory lock is not held by the session")
+
+// AdvisoryLockHandle is a struct for wrapping an acquired postgres advisory lock
+type AdvisoryLockHandle struct {
+	Key   int64
+	conn  *sql.Conn
+	query *Query
+}
+
+// Unlock is a function that releases the advisory lock, transaction scoped locks are released on commit or rollback so it does nothing.
+// The unlock is not cancelled with ctx, otherwise the connection would return to the pool still holding the lock
+func (l *AdvisoryLockHandle) Unlock(ctx context.Context) error {
+	var released bool
+
+	if nil == l.conn {
+		return nil
+	}
+
+	defer l.conn.Close()
+
+	result, err := l.query.executeOn(detachedContext{ctx}, runOnConn(l.query, l.conn), STMT_RAW, "SELECT pg_advisory_unlock($1)", []interface{}{l.Key})
+
+	if nil != err {
+		return err
+	}
+
+	if err := l.query.scanOne(result.Rows, &released); nil != err {
+		return err
+	}
+
+	if !released {
+		return ErrAdvisoryLockNotHeld
+	}
+
+	return nil
+}
+
+// detachedContext is a struct for wrapping a context that keeps its values but is never cancelled
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (c detachedContext) Done() <-chan struct{} { return nil }
+
+func (c detachedContext) Err() error { return nil }
+
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// runOnConn is a function that returns a handler running positional statements on the dedicated connection
+func runOnConn(q *Query, conn *sql.Conn) Handler {
+	return func(ctx context.Context, stmt Statement) (Result, error) {
+		args, _ := stmt.Args.([]interface{})
+
+		rows, err := conn.QueryContext(ctx, stmt.Query, args...)
+
+		if nil != err {
+			return Result{}, err
+		}
+
+		return Result{Rows: &sqlx.Rows{Rows: rows, Mapper: q.DB.Mapper}}, nil
+	}
+}
+
+// AdvisoryKey is a function that derives a stable advisory lock key from a name
+func AdvisoryKey(name string) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(name))
+
+	return int64(hash.Sum64())
+}
+
+// AdvisoryLock is a function that waits until the session advisory lock of the key is acquired.
+// The lock holds a dedicated connection until it is unlocked
+func AdvisoryLock(ctx context.Context, q *Query, key int64) (*AdvisoryLockHandle, error) {
+	return acquireAdvisoryLock(ctx, q, "SELECT true FROM pg_advisory_lock($1)", key)
+}
+
+// TryAdvisoryLock is a function that acquires the session advisory lock of the key without waiting, ErrAdvisoryLockNotAcquired is returned when it is held by another session
+func TryAdvisoryLock(ctx context.Context, q *Query, key int64) (*AdvisoryLockHandle, error) {
+	return acquireAdvisoryLock(ctx, q, "SELECT pg_try_advisory_lock($1)", key)
+}
+
+// AdvisoryXactLock is a function that waits until the transaction advisory lock of the key is acquired, it requires an active transaction
+func AdvisoryXactLock(ctx context.Context, q *Query, key int64) (*AdvisoryLockHandle, error) {
+	return acquireAdvisoryXactLock(ctx, q, "SELECT true FROM pg_advisory_xact_lock($1)", key)
+}
+
+// TryAdvisoryXactLock is a function that acquires the transaction advisory lock of the key without waiting, it requires an active transaction
+func TryAdvisoryXactLock(ctx context.Context, q *Query, key int64) (*AdvisoryLockHandle, error) {
+	return acquireAdvisoryXactLock(ctx, q, "SELECT pg_try_advisory_xact_lock($1)", key)
+}
+
+func acquireAdvisoryLock(ctx context.Context, q *Query, query string, key int64) (*AdvisoryLockHandle, error) {
+	var acquired bool
+
+	conn, err := q.DB.Conn(ctx)
+
+	if nil != err {
+		return nil, err
+	}
+
+	result, err := q.executeOn(ctx, runOnConn(q, conn), STMT_RAW, query, []interface{}{key})
+
+	if nil == err {
+		err = q.scanOne(result.Rows, &acquired)
+	}
+
+	if nil != err {
+		conn.Close()
+
+		return nil, err
+	}
+
+	if !acquired {
+		conn.Close()
+
+		return nil, ErrAdvisoryLockNotAcquired
+	}
+
+	return &AdvisoryLockHandle{Key: key, conn: conn, query: q}, nil
+}
+
+func acquireAdvisoryXactLock(ctx context.Context, q *Query, query string, key int64) (*AdvisoryLockHandle, error) {
+	var acquired bool
+
+	if nil == q.Tx {
+		return nil, ErrLockWithoutTransaction
+	}
+
+	result, err := q.executeOn(ctx, q.run, STMT_RAW, query, []interface{}{key})
+
+	if nil != err {
+		return nil, err
+	}
+
+	if err := q.scanOne(result.Rows, &acquired); nil != err {
+		return nil, err
+	}
+
+	if !acquired {
+		return nil, ErrAdvisoryLockNotAcquired
+	}
+
+	return &AdvisoryLockHandle{Key: key}, nil
+}
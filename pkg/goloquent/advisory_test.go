@@ -0,0 +1,118 @@
+package goloquent
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdvisory_Key(t *testing.T) {
+	t.Run("TestAdvisory_STABLE_KEY", func(t *testing.T) {
+		require.Equal(t, AdvisoryKey("nightly-export"), AdvisoryKey("nightly-export"))
+		require.Equal(t, int64(-3750763034362895579), AdvisoryKey(""))
+		require.NotEqual(t, AdvisoryKey("nightly-export"), AdvisoryKey("nightly-import"))
+	})
+}
+
+func TestAdvisory_XactLockWithoutTransaction(t *testing.T) {
+	t.Run("TestAdvisory_XACT_LOCK", func(t *testing.T) {
+		_, err := AdvisoryXactLock(context.Background(), DB(nil), AdvisoryKey("migrate"))
+
+		require.Equal(t, ErrLockWithoutTransaction, err)
+	})
+}
+
+// advisoryRows is a function that answers advisory lock queries, try locks with acquired and unlocks with released
+func advisoryRows(acquired bool, released bool) fakeResponder {
+	return func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		switch {
+		case strings.Contains(query, "pg_advisory_unlock"):
+			return []string{"released"}, [][]driver.Value{{released}}
+		case strings.Contains(query, "pg_try_advisory"):
+			return []string{"acquired"}, [][]driver.Value{{acquired}}
+		}
+
+		return []string{"acquired"}, [][]driver.Value{{true}}
+	}
+}
+
+// recordStatements is a function that returns an interceptor appending every statement to statements
+func recordStatements(statements *[]Statement) Interceptor {
+	return func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+		*statements = append(*statements, stmt)
+
+		return next(ctx, stmt)
+	}
+}
+
+func TestAdvisory_Lock(t *testing.T) {
+	t.Run("TestAdvisory_LOCK_UNLOCK", func(t *testing.T) {
+		var statements []Statement
+
+		db, fake := newFakeDB(t, advisoryRows(true, true))
+
+		lock, err := AdvisoryLock(context.Background(), DB(db).Intercept(recordStatements(&statements)), 42)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(42), lock.Key)
+		require.Equal(t, 1, db.Stats().InUse)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		require.NoError(t, lock.Unlock(ctx))
+		require.Equal(t, 0, db.Stats().InUse)
+		require.Equal(t, []string{"SELECT true FROM pg_advisory_lock($1)", "SELECT pg_advisory_unlock($1)"}, fake.Statements)
+		require.Len(t, statements, 2)
+		require.Equal(t, []interface{}{int64(42)}, statements[0].Args)
+	})
+
+	t.Run("TestAdvisory_TRY_LOCK_HELD", func(t *testing.T) {
+		db, _ := newFakeDB(t, advisoryRows(false, true))
+
+		lock, err := TryAdvisoryLock(context.Background(), DB(db), 42)
+
+		require.Nil(t, lock)
+		require.Equal(t, ErrAdvisoryLockNotAcquired, err)
+		require.Equal(t, 0, db.Stats().InUse)
+	})
+
+	t.Run("TestAdvisory_UNLOCK_NOT_HELD", func(t *testing.T) {
+		db, _ := newFakeDB(t, advisoryRows(true, false))
+
+		lock, err := AdvisoryLock(context.Background(), DB(db), 42)
+
+		require.NoError(t, err)
+		require.Equal(t, ErrAdvisoryLockNotHeld, lock.Unlock(context.Background()))
+		require.Equal(t, 0, db.Stats().InUse)
+	})
+
+	t.Run("TestAdvisory_XACT_LOCK_INTERCEPTED", func(t *testing.T) {
+		var statements []Statement
+
+		db, _ := newFakeDB(t, advisoryRows(true, true))
+
+		query := DB(db).Intercept(recordStatements(&statements)).BeginTransaction()
+		defer query.Rollback()
+
+		_, err := AdvisoryXactLock(context.Background(), query, 42)
+
+		require.NoError(t, err)
+		require.Len(t, statements, 1)
+		require.Equal(t, "SELECT true FROM pg_advisory_xact_lock($1)", statements[0].Query)
+	})
+
+	t.Run("TestAdvisory_TRY_XACT_LOCK_HELD", func(t *testing.T) {
+		db, _ := newFakeDB(t, advisoryRows(false, true))
+
+		query := DB(db).BeginTransaction()
+		defer query.Rollback()
+
+		_, err := TryAdvisoryXactLock(context.Background(), query, 42)
+
+		require.Equal(t, ErrAdvisoryLockNotAcquired, err)
+	})
+}
@@ -4,6 +4,8 @@ package goloquent
 type Aggregate struct {
 	AggregateFunc AggregateFunction
 	Column        string
+	Distinct      bool
+	Alias         string
 }
 
 func newAggregate(aggregateFn AggregateFunction, column string) *Aggregate {
@@ -12,3 +14,35 @@ func newAggregate(aggregateFn AggregateFunction, column string) *Aggregate {
 		Column:        column,
 	}
 }
+
+// Count is a function that will create a COUNT aggregate to be used with SelectAggregates
+func Count(column string) *Aggregate {
+	return newAggregate(COUNT, column)
+}
+
+// Max is a function that will create a MAX aggregate to be used with SelectAggregates
+func Max(column string) *Aggregate {
+	return newAggregate(MAX, column)
+}
+
+// Min is a function that will create a MIN aggregate to be used with SelectAggregates
+func Min(column string) *Aggregate {
+	return newAggregate(MIN, column)
+}
+
+// Avg is a function that will create an AVG aggregate to be used with SelectAggregates
+func Avg(column string) *Aggregate {
+	return newAggregate(AVG, column)
+}
+
+// Sum is a function that will create a SUM aggregate to be used with SelectAggregates
+func Sum(column string) *Aggregate {
+	return newAggregate(SUM, column)
+}
+
+// As method sets the alias of the aggregate result column
+func (a *Aggregate) As(alias string) *Aggregate {
+	a.Alias = alias
+
+	return a
+}
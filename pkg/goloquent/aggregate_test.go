@@ -20,6 +20,27 @@ func TestAggregate_Count(t *testing.T) {
 			Column:        "*",
 		})
 	})
+
+	t.Run("TestAggregate_COUNT_QUERY", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(4))
+
+		require.Equal(t, int64(4), DB(db).Use(genreModel()).Where("name", EQUAL, "a").Count())
+		require.Equal(t, `SELECT COUNT("genres".*) FROM "genres" WHERE "genres"."name" = $1 `, fake.Statements[0])
+	})
+
+	t.Run("TestAggregate_COUNT_DISTINCT_QUERY", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(2))
+
+		require.Equal(t, int64(2), DB(db).Use(genreModel()).Distinct().Select("name").Count())
+		require.Equal(t, `SELECT COUNT("aggregate".*) FROM (SELECT DISTINCT "genres"."name" FROM "genres") AS "aggregate" `, fake.Statements[0])
+	})
+
+	t.Run("TestAggregate_COUNT_DISTINCT_ON_QUERY", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(2))
+
+		require.Equal(t, int64(2), DB(db).Use(genreModel()).DistinctOn("name").Count())
+		require.Equal(t, `SELECT COUNT("aggregate".*) FROM (SELECT DISTINCT ON ("genres"."name") "genres"."id", "genres"."name" FROM "genres") AS "aggregate" `, fake.Statements[0])
+	})
 }
 
 func TestAggregate_Max(t *testing.T) {
@@ -36,6 +57,27 @@ func TestAggregate_Max(t *testing.T) {
 			Column:        "*",
 		})
 	})
+
+	t.Run("TestAggregate_COUNT_QUERY", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(4))
+
+		require.Equal(t, int64(4), DB(db).Use(genreModel()).Where("name", EQUAL, "a").Count())
+		require.Equal(t, `SELECT COUNT("genres".*) FROM "genres" WHERE "genres"."name" = $1 `, fake.Statements[0])
+	})
+
+	t.Run("TestAggregate_COUNT_DISTINCT_QUERY", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(2))
+
+		require.Equal(t, int64(2), DB(db).Use(genreModel()).Distinct().Select("name").Count())
+		require.Equal(t, `SELECT COUNT("aggregate".*) FROM (SELECT DISTINCT "genres"."name" FROM "genres") AS "aggregate" `, fake.Statements[0])
+	})
+
+	t.Run("TestAggregate_COUNT_DISTINCT_ON_QUERY", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(2))
+
+		require.Equal(t, int64(2), DB(db).Use(genreModel()).DistinctOn("name").Count())
+		require.Equal(t, `SELECT COUNT("aggregate".*) FROM (SELECT DISTINCT ON ("genres"."name") "genres"."id", "genres"."name" FROM "genres") AS "aggregate" `, fake.Statements[0])
+	})
 }
 
 func TestAggregate_Min(t *testing.T) {
@@ -0,0 +1,20 @@
+package goloquent
+
+import "github.com/lib/pq"
+
+// StringArray is a model field type for mapping TEXT[] or VARCHAR[] column
+type StringArray = pq.StringArray
+
+// Int64Array is a model field type for mapping INTEGER[] or BIGINT[] column
+type Int64Array = pq.Int64Array
+
+// Float64Array is a model field type for mapping REAL[], DOUBLE[] or NUMERIC[] column
+type Float64Array = pq.Float64Array
+
+// BoolArray is a model field type for mapping BOOLEAN[] column
+type BoolArray = pq.BoolArray
+
+// ArrayOf is a function that returns the array data type of the given data type
+func ArrayOf(dt DataType) DataType {
+	return dt + DT_ARRAY
+}
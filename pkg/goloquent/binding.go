@@ -2,10 +2,29 @@ package goloquent
 
 // Binding .
 type Binding struct {
+	CTEs       []*CTE
 	Aggregate  *Aggregate
+	Aggregates []*Aggregate
+	Distinct   bool
+	DistinctOn []string
+	Columns    []string
+	Selects    []*SubQuery
+	Windows    []*WindowFunction
+	From       *SubQuery
 	Conditions []*Condition
 	Limit      int
 	Offset     int
 	GroupBy    []string
-	Order      *Order
+	Havings    []*Condition
+	Compounds  []*Compound
+	Orders     []*Order
+	Lock       LockMode
+	LockWait   LockWait
+	prefix     string
+}
+
+// lockable is a function that reports whether the selected rows map to table rows that can be locked
+func (b Binding) lockable() bool {
+	return nil == b.Aggregate && len(b.Aggregates) == 0 && len(b.Windows) == 0 && !b.Distinct && len(b.DistinctOn) == 0 &&
+		len(b.GroupBy) == 0 && len(b.Havings) == 0 && len(b.Compounds) == 0
 }
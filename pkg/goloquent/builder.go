@@ -3,6 +3,7 @@ package goloquent
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -57,6 +58,10 @@ func (b *Builder) BuildCreateTable(blueprint *Schema) string {
 		query = fmt.Sprintf("%s%s", query, b.buildIndexQuery(blueprint))
 	}
 
+	for _, fullText := range blueprint.fullTexts {
+		query = fmt.Sprintf("%s%s", query, b.buildFullTextIndexQuery(blueprint, fullText))
+	}
+
 	return query
 }
 
@@ -91,6 +96,10 @@ func (b *Builder) BuildAlterTable(schema *Schema) string {
 		query = append(query, dropColumn)
 	}
 
+	for _, fullText := range schema.fullTexts {
+		query = append(query, strings.TrimSpace(b.buildFullTextIndexQuery(schema, fullText)))
+	}
+
 	return strings.Join(query, "\n")
 }
 
@@ -105,37 +114,58 @@ func (b *Builder) BuildDropTable(schema *Schema) string {
 	return query
 }
 
+// BuildExists is a function that will wrap the select statement of the binding into SELECT EXISTS(...)
+func (b *Builder) BuildExists(model IModel, binding Binding) string {
+	return fmt.Sprintf(`SELECT EXISTS(%s) `, strings.TrimSpace(b.BuildSelect(model, binding)))
+}
+
 // BuildSelect .
 func (b *Builder) BuildSelect(model IModel, binding Binding) string {
 	var query string
 
+	table := b.tableOf(model, binding)
+
 	query = fmt.Sprintf("%sSELECT", query)
 
 	if nil != binding.Aggregate {
-		query = fmt.Sprintf("%s %s", query, b.buildSelectAggregate(binding.Aggregate.AggregateFunc, model.GetTableName(), binding.Aggregate.Column))
-	} else if len(model.GetColumns(model)) > 0 {
-		query = fmt.Sprintf(`%s %s`, query, b.buildSelectColumns(model.GetTableName(), model.GetColumns(model)))
-
-		if model.IsTimestamp() {
-			query = fmt.Sprintf(`%s, "%s"."created_at", `, query, model.GetTableName())
-			query = fmt.Sprintf(`%s"%s"."updated_at"`, query, model.GetTableName())
-		}
+		query = fmt.Sprintf("%s %s", query, b.buildSelectAggregate(binding.Aggregate, table))
+	} else {
+		query = fmt.Sprintf("%s%s", query, b.buildDistinct(table, binding))
 
-		if model.IsSoftDelete() {
-			query = fmt.Sprintf(`%s, "%s"."deleted_at"`, query, model.GetTableName())
+		if columns := b.buildSelectList(model, binding); "" != columns {
+			query = fmt.Sprintf(`%s %s `, query, columns)
 		}
-
-		query = fmt.Sprintf("%s ", query)
 	}
 
-	query = fmt.Sprintf(`%sFROM "%s" `, query, model.GetTableName())
+	if nil != binding.From && nil == binding.From.Query {
+		query = fmt.Sprintf(`%sFROM "%s" `, query, binding.From.Alias)
+	} else if nil != binding.From {
+		query = fmt.Sprintf(`%sFROM (%s) AS "%s" `, query, b.buildSubQuery(binding.From.Query, binding.prefix+"f_"), binding.From.Alias)
+	} else {
+		query = fmt.Sprintf(`%sFROM "%s" `, query, table)
+	}
 
 	if len(binding.Conditions) > 0 {
-		query = fmt.Sprintf(`%sWHERE %s `, query, b.buildQueryCondition(model.GetTableName(), binding.Conditions))
+		query = fmt.Sprintf(`%sWHERE %s `, query, b.buildQueryCondition(table, binding.prefix, binding.Conditions))
 	}
 
 	if len(binding.GroupBy) > 0 {
-		query = fmt.Sprintf(`%sGROUP BY %s `, query, b.buildGroupByColumns(model.GetTableName(), binding.GroupBy))
+		query = fmt.Sprintf(`%sGROUP BY %s `, query, b.buildGroupByColumns(table, binding.GroupBy))
+	}
+
+	if len(binding.Havings) > 0 {
+		query = fmt.Sprintf(`%sHAVING %s `, query, b.buildQueryCondition(table, binding.prefix+"h_", binding.Havings))
+	}
+
+	if len(binding.Compounds) > 0 {
+		query = fmt.Sprintf(`(%s) %s `, strings.TrimSpace(query), b.buildCompounds(binding))
+
+		// the order of compound select applies to the combined result, so the columns can not be qualified
+		table = ""
+	}
+
+	if len(binding.Orders) > 0 {
+		query = fmt.Sprintf(`%sORDER BY %s `, query, b.buildOrderColumns(table, binding.prefix, binding.Orders))
 	}
 
 	if binding.Limit > 0 {
@@ -143,14 +173,18 @@ func (b *Builder) BuildSelect(model IModel, binding Binding) string {
 	}
 
 	if binding.Offset > 0 {
-		query = fmt.Sprintf(`%sOFFSET %d;`, query, binding.Offset)
+		query = fmt.Sprintf(`%sOFFSET %d `, query, binding.Offset)
 	}
 
-	if nil != binding.Order {
-		query = fmt.Sprintf(`%sORDER BY %s %s;`, query, b.buildOrderColumns(model.GetTableName(), binding.Order.Columns), binding.Order.Direction)
+	if "" != binding.Lock {
+		query = fmt.Sprintf(`%s%s `, query, binding.Lock)
 	}
 
-	return query
+	if "" != binding.LockWait {
+		query = fmt.Sprintf(`%s%s `, query, binding.LockWait)
+	}
+
+	return fmt.Sprintf("%s%s", b.buildWith(binding), query)
 }
 
 // BuildInsert .
@@ -159,10 +193,10 @@ func (b *Builder) BuildInsert(model IModel, returning ...string) string {
 
 	query = fmt.Sprintf("%sINSERT INTO %s ", query, model.GetTableName())
 	query = fmt.Sprintf("%s(%s) ", query, b.buildInsertColumnOrValue(model, b.isAutoIncrementPrimaryKey, b.buildInsertColumns))
-	query = fmt.Sprintf("%sVALUES (%s) ", query, b.buildInsertColumnOrValue(model, b.isAutoIncrementPrimaryKey, b.buildInsertValue))
+	query = fmt.Sprintf("%sVALUES (%s) ", query, b.buildInsertColumnOrValue(model, b.isAutoIncrementPrimaryKey, b.buildInsertValueOf(model.MapToPayload(model))))
 
 	if len(returning) < 1 {
-		returning = append(returning, model.GetPK())
+		returning = append(returning, model.GetPrimaryKeys()...)
 	}
 
 	query = fmt.Sprintf("%sRETURNING \"%s\";\n", query, strings.Join(returning, `", "`))
@@ -170,32 +204,100 @@ func (b *Builder) BuildInsert(model IModel, returning ...string) string {
 	return query
 }
 
-// BuildUpdate .
-func (b *Builder) BuildUpdate(model IModel) string {
+// BuildUpsert is a function that will generate an insert statement updating the given columns when the conflict columns already exist
+func (b *Builder) BuildUpsert(model IModel, conflict []string, update []string) string {
+	var query string
+
+	query = fmt.Sprintf("%sINSERT INTO %s ", query, model.GetTableName())
+	query = fmt.Sprintf("%s(%s) ", query, b.buildInsertColumnOrValue(model, b.isAutoIncrementPrimaryKey, b.buildInsertColumns))
+	query = fmt.Sprintf("%sVALUES (%s) ", query, b.buildInsertColumnOrValue(model, b.isAutoIncrementPrimaryKey, b.buildInsertValueOf(model.MapToPayload(model))))
+
+	var sets []string
+	for _, col := range update {
+		if model.IsVersioned() && model.GetVersionColumn() == col {
+			continue
+		}
+
+		sets = append(sets, fmt.Sprintf(`"%s"=EXCLUDED."%s"`, col, col))
+	}
+
+	if model.IsVersioned() {
+		column := model.GetVersionColumn()
+
+		sets = append(sets, fmt.Sprintf(`"%s"="%s"."%s"+1`, column, model.GetTableName(), column))
+	}
+
+	query = fmt.Sprintf(`%sON CONFLICT ("%s") DO UPDATE SET %s `, query, strings.Join(conflict, `", "`), strings.Join(sets, ", "))
+
+	returning := b.buildInsertColumnOrValue(model, func(string, IModel) bool { return false }, b.buildInsertColumns)
+
+	return fmt.Sprintf("%sRETURNING %s;\n", query, returning)
+}
+
+// BuildUpdate is a function that will generate the update statement of the given columns of the model
+func (b *Builder) BuildUpdate(model IModel, binding Binding, columns []string) string {
 	var query string
 
-	query = fmt.Sprintf("%sUPDATE %s ", query, model.GetTableName())
-	query = fmt.Sprintf("%sSET %s", query, b.buildUpdateValue(model))
-	query = fmt.Sprintf(`%sWHERE "%s"=:%s;`, query, model.GetPK(), model.GetPK())
+	query = fmt.Sprintf("%s%sUPDATE %s ", query, b.buildWith(binding), model.GetTableName())
+	query = fmt.Sprintf("%sSET %s", query, b.buildUpdateValue(model, columns))
+
+	if model.IsVersioned() {
+		column := model.GetVersionColumn()
+
+		query = fmt.Sprintf(`%sWHERE %s AND "%s"=:old_%s;`, query, b.buildPrimaryKeyCondition(model), column, column)
+	} else {
+		query = fmt.Sprintf(`%sWHERE %s;`, query, b.buildPrimaryKeyCondition(model))
+	}
 
 	return query
 }
 
 // BuildDelete .
-func (b *Builder) BuildDelete(model IModel, conditions []*Condition) string {
+func (b *Builder) BuildDelete(model IModel, binding Binding) string {
 	var query string
 
-	query = fmt.Sprintf("%sDELETE FROM %s ", query, model.GetTableName())
+	query = fmt.Sprintf("%s%sDELETE FROM %s ", query, b.buildWith(binding), model.GetTableName())
 
-	if len(conditions) == 0 {
-		query = fmt.Sprintf(`%sWHERE "%s"=:%s;`, query, model.GetPK(), model.GetPK())
+	if len(binding.Conditions) == 0 {
+		query = fmt.Sprintf(`%sWHERE %s;`, query, b.buildPrimaryKeyCondition(model))
 	} else {
-		query = fmt.Sprintf("%sWHERE %s;", query, b.buildQueryCondition(model.GetTableName(), conditions))
+		query = fmt.Sprintf("%sWHERE %s;", query, b.buildQueryCondition(model.GetTableName(), binding.prefix, binding.Conditions))
 	}
 
 	return query
 }
 
+// BuildUpdateColumns .
+func (b *Builder) BuildUpdateColumns(model IModel, binding Binding, values map[string]interface{}) string {
+	var query string
+
+	columns := make([]string, 0, len(values))
+	for col := range values {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns)
+
+	var sets []string
+	for _, col := range columns {
+		if expression, ok := values[col].(Expression); ok {
+			sql, _ := expression.bind(fmt.Sprintf("u_%s", col))
+
+			sets = append(sets, fmt.Sprintf(`"%s"=%s`, col, sql))
+		} else {
+			sets = append(sets, fmt.Sprintf(`"%s"=:u_%s`, col, col))
+		}
+	}
+
+	query = fmt.Sprintf("%s%sUPDATE %s ", query, b.buildWith(binding), model.GetTableName())
+	query = fmt.Sprintf("%sSET %s ", query, strings.Join(sets, ", "))
+
+	if len(binding.Conditions) > 0 {
+		query = fmt.Sprintf("%sWHERE %s", query, b.buildQueryCondition(model.GetTableName(), binding.prefix, binding.Conditions))
+	}
+
+	return fmt.Sprintf("%s;", strings.TrimSpace(query))
+}
+
 // BuildBulkInsert .
 func (b *Builder) BuildBulkInsert(model IModel, data []interface{}, returning ...string) string {
 	var query string
@@ -216,7 +318,7 @@ func (b *Builder) BuildBulkInsert(model IModel, data []interface{}, returning ..
 	}
 
 	if len(returning) < 1 {
-		returning = append(returning, model.GetPK())
+		returning = append(returning, model.GetPrimaryKeys()...)
 	}
 
 	query = fmt.Sprintf("%s RETURNING \"%s\";\n", query, strings.Join(returning, `", "`))
@@ -241,7 +343,9 @@ func (b *Builder) buildColumnQuery(column *Column) string {
 		query = fmt.Sprintf("%s NOT NULL", query)
 	}
 
-	if nil != column.defaultValue {
+	if expression, ok := column.defaultValue.(Expression); ok {
+		query = fmt.Sprintf("%s DEFAULT %s", query, expression.SQL)
+	} else if nil != column.defaultValue {
 		query = fmt.Sprintf("%s DEFAULT '%v'", query, column.defaultValue)
 	}
 
@@ -277,6 +381,20 @@ func (b *Builder) buildIndexQuery(blueprint *Schema) string {
 	return query
 }
 
+func (b *Builder) buildFullTextIndexQuery(blueprint *Schema, fullText *FullText) string {
+	var query string
+
+	query = fmt.Sprintf(
+		"CREATE INDEX IF NOT EXISTS %s_%s_fulltext ON %s USING GIN (%s);\n",
+		blueprint.name,
+		strings.Join(fullText.columns, "_"),
+		blueprint.name,
+		buildTsVector("", fullText.columns, fullText.options()),
+	)
+
+	return query
+}
+
 func (b *Builder) buildAddColumnQuery(columns ...*Column) string {
 	var query []string
 
@@ -322,11 +440,52 @@ func (b *Builder) buildDropColumnQuery(columns ...string) string {
 	return strings.Join(query, ",")
 }
 
+// buildPrimaryKeyCondition is a function that will generate the predicate matching every primary key column of the model
+func (b *Builder) buildPrimaryKeyCondition(model IModel) string {
+	var conditions []string
+
+	for _, pk := range model.GetPrimaryKeys() {
+		conditions = append(conditions, fmt.Sprintf(`"%s"=:%s`, pk, pk))
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
 // isAutoIncrementPrimaryKey is a function that will skip id column if model is autoincrement when building query
 func (b *Builder) isAutoIncrementPrimaryKey(column string, model IModel) bool {
 	return column == model.GetPK() && !model.IsUuid() && model.IsAutoIncrement()
 }
 
+// buildInsertColumnList is a function that will return the inserted columns of the model
+func (b *Builder) buildInsertColumnList(model IModel) []string {
+	var columns []string
+
+	for _, col := range append(model.GetColumns(model), b.buildMetaColumns(model)...) {
+		if !b.isAutoIncrementPrimaryKey(col, model) {
+			columns = append(columns, col)
+		}
+	}
+
+	return columns
+}
+
+// countInsertParameters is a function that will count the bind parameters used to insert the model
+func (b *Builder) countInsertParameters(model IModel) int {
+	count := 0
+
+	payload := model.MapToPayload(model)
+
+	for _, col := range b.buildInsertColumnList(model) {
+		if expression, ok := payload[col].(Expression); ok {
+			count += len(expression.Args)
+		} else {
+			count++
+		}
+	}
+
+	return count
+}
+
 // buildInsertColumnOrValue is a decorator function to wrap creational of columns or values
 func (b *Builder) buildInsertColumnOrValue(
 	model IModel,
@@ -336,15 +495,7 @@ func (b *Builder) buildInsertColumnOrValue(
 	var query string
 	hasComma := true
 
-	columns := model.GetColumns(model)
-
-	if model.IsTimestamp() {
-		columns = append(columns, "created_at", "updated_at")
-	}
-
-	if model.IsSoftDelete() {
-		columns = append(columns, "deleted_at")
-	}
+	columns := append(model.GetColumns(model), b.buildMetaColumns(model)...)
 
 	for _, col := range columns {
 		if !skipFunc(col, model) {
@@ -375,20 +526,43 @@ func (b *Builder) buildInsertValue(query string, column string, hasComma bool) (
 	return fmt.Sprintf(`%s:%s`, query, column), hasComma
 }
 
-func (b *Builder) buildUpdateValue(model IModel) string {
-	var query string
+// buildInsertValueOf is a function that returns insert value generator which writes raw expressions of the payload as is
+func (b *Builder) buildInsertValueOf(payload map[string]interface{}) func(query string, column string, hasComma bool) (string, bool) {
+	return func(query string, column string, hasComma bool) (string, bool) {
+		expression, ok := payload[column].(Expression)
+
+		if !ok {
+			return b.buildInsertValue(query, column, hasComma)
+		}
 
-	columns := model.GetColumns(model)
+		if hasComma {
+			hasComma = false
+		} else {
+			query = fmt.Sprintf("%s, ", query)
+		}
 
-	if model.IsTimestamp() {
-		columns = append(columns, "updated_at")
+		sql, _ := expression.bind(fmt.Sprintf("e_%s", column))
+
+		return fmt.Sprintf(`%s%s`, query, sql), hasComma
 	}
+}
+
+func (b *Builder) buildUpdateValue(model IModel, columns []string) string {
+	var query string
+
+	payload := model.MapToPayload(model)
 
 	for i, v := range columns {
+		value := fmt.Sprintf(":%s", v)
+
+		if expression, ok := payload[v].(Expression); ok {
+			value, _ = expression.bind(fmt.Sprintf("e_%s", v))
+		}
+
 		if i == len(columns)-1 {
-			query = fmt.Sprintf(`%s"%s"=:%s `, query, v, v)
+			query = fmt.Sprintf(`%s"%s"=%s `, query, v, value)
 		} else {
-			query = fmt.Sprintf(`%s"%s"=:%s, `, query, v, v)
+			query = fmt.Sprintf(`%s"%s"=%s, `, query, v, value)
 		}
 	}
 
@@ -396,19 +570,21 @@ func (b *Builder) buildUpdateValue(model IModel) string {
 }
 
 func (b *Builder) buildInsertBulkValue(query string, model IModel, i int) string {
-	columns := model.GetColumns(model)
-
-	if model.IsTimestamp() {
-		columns = append(columns, "created_at", "updated_at")
-	}
+	columns := append(model.GetColumns(model), b.buildMetaColumns(model)...)
 
-	if model.IsSoftDelete() {
-		columns = append(columns, "deleted_at")
-	}
+	payload := model.MapToPayload(model)
 
 	var qCol []string
 	for _, col := range columns {
-		if !b.isAutoIncrementPrimaryKey(col, model) {
+		if b.isAutoIncrementPrimaryKey(col, model) {
+			continue
+		}
+
+		if expression, ok := payload[col].(Expression); ok {
+			sql, _ := expression.bind(fmt.Sprintf("e_%d%s", i, col))
+
+			qCol = append(qCol, sql)
+		} else {
 			qCol = append(qCol, fmt.Sprintf(":%d%s", i, col))
 		}
 	}
@@ -416,115 +592,495 @@ func (b *Builder) buildInsertBulkValue(query string, model IModel, i int) string
 	return fmt.Sprintf(`%s(%s)`, query, strings.Join(qCol, ", "))
 }
 
+// buildMetaColumns is a function that will return the columns managed by the model configuration
+func (b *Builder) buildMetaColumns(model IModel) []string {
+	var columns []string
+
+	if model.IsTimestamp() {
+		columns = append(columns, CREATED_AT, UPDATED_AT)
+	}
+
+	if model.IsSoftDelete() {
+		columns = append(columns, DELETED_AT)
+	}
+
+	return columns
+}
+
 func (b *Builder) buildSelectColumns(table string, columns []string) string {
 	return b.mapColumnsToQuery(table, columns)
 }
 
-func (b *Builder) buildSelectAggregate(aggregateFn AggregateFunction, table string, column string) string {
+// buildSelectList is a function that will generate the selected columns, model columns are used when no column is selected
+func (b *Builder) buildSelectList(model IModel, binding Binding) string {
+	var columns []string
+
+	table := b.tableOf(model, binding)
+
+	if len(binding.Columns) > 0 {
+		for _, col := range binding.Columns {
+			columns = append(columns, b.qualifyColumn(table, col))
+		}
+	} else if len(binding.Aggregates) > 0 {
+		for _, col := range binding.GroupBy {
+			columns = append(columns, b.qualifyColumn(table, col))
+		}
+	} else {
+		aliases := map[string]bool{}
+		for _, sub := range binding.Selects {
+			aliases[sub.Alias] = true
+		}
+		for _, window := range binding.Windows {
+			aliases[window.Alias] = true
+		}
+
+		var modelColumns []string
+		for _, col := range model.GetColumns(model) {
+			if !aliases[col] {
+				modelColumns = append(modelColumns, col)
+			}
+		}
+
+		modelColumns = append(modelColumns, b.buildMetaColumns(model)...)
+
+		if len(modelColumns) > 0 {
+			columns = append(columns, b.buildSelectColumns(table, modelColumns))
+		}
+	}
+
+	for i, sub := range binding.Selects {
+		columns = append(columns, fmt.Sprintf(`(%s) AS "%s"`, b.buildSubQuery(sub.Query, fmt.Sprintf("%sc%d_", binding.prefix, i)), sub.Alias))
+	}
+
+	for _, window := range binding.Windows {
+		columns = append(columns, b.buildWindowColumn(window, table))
+	}
+
+	for _, aggregate := range binding.Aggregates {
+		columns = append(columns, b.buildAggregateColumn(aggregate, table))
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+// buildWith is a function that will generate the common table expressions of the statement
+func (b *Builder) buildWith(binding Binding) string {
+	if len(binding.CTEs) == 0 {
+		return ""
+	}
+
+	keyword := "WITH"
+
+	var ctes []string
+	for i, cte := range binding.CTEs {
+		body := b.buildSubQuery(cte.Query, fmt.Sprintf("%sw%d_", binding.prefix, i))
+
+		if nil != cte.Recursive {
+			keyword = "WITH RECURSIVE"
+
+			body = fmt.Sprintf("%s UNION ALL %s", body, b.buildSubQuery(cte.Recursive, fmt.Sprintf("%sw%dr_", binding.prefix, i)))
+		}
+
+		ctes = append(ctes, fmt.Sprintf(`"%s" AS (%s)`, cte.Name, body))
+	}
+
+	return fmt.Sprintf("%s %s ", keyword, strings.Join(ctes, ", "))
+}
+
+// buildCompounds is a function that will generate the set operations of a compound select
+func (b *Builder) buildCompounds(binding Binding) string {
+	var compounds []string
+
+	for i, compound := range binding.Compounds {
+		compounds = append(compounds, fmt.Sprintf("%s (%s)", compound.Operator, b.buildSubQuery(compound.Query, fmt.Sprintf("%su%d_", binding.prefix, i))))
+	}
+
+	return strings.Join(compounds, " ")
+}
+
+// buildWithPayload is a function that will map every named parameter of the common table expressions into payload
+func (b *Builder) buildWithPayload(binding Binding) map[string]interface{} {
+	payload := map[string]interface{}{}
+
+	for i, cte := range binding.CTEs {
+		b.mergePayload(payload, b.buildSubQueryPayload(cte.Query, fmt.Sprintf("%sw%d_", binding.prefix, i)))
+
+		if nil != cte.Recursive {
+			b.mergePayload(payload, b.buildSubQueryPayload(cte.Recursive, fmt.Sprintf("%sw%dr_", binding.prefix, i)))
+		}
+	}
+
+	return payload
+}
+
+// buildSubQuery is a function that will generate the select statement of a subquery, every named parameter is prefixed to avoid collision with the outer query
+func (b *Builder) buildSubQuery(sub *Query, prefix string) string {
+	binding := sub.Binding
+	binding.prefix = prefix
+
+	return strings.TrimSpace(b.BuildSelect(sub.Model, binding))
+}
+
+// tableOf is a function that returns the name used to qualify columns of the statement
+func (b *Builder) tableOf(model IModel, binding Binding) string {
+	if nil != binding.From {
+		return binding.From.Alias
+	}
+
+	return model.GetTableName()
+}
+
+func (b *Builder) qualifyColumn(table string, column string) string {
 	if "*" == column {
-		return fmt.Sprintf(`%v("%s".%s) `, aggregateFn, table, column)
+		return fmt.Sprintf(`"%s".*`, table)
+	}
+
+	return fmt.Sprintf(`"%s"."%s"`, table, column)
+}
+
+func (b *Builder) buildDistinct(table string, binding Binding) string {
+	if len(binding.DistinctOn) > 0 {
+		return fmt.Sprintf(" DISTINCT ON (%s)", b.mapColumnsToQuery(table, binding.DistinctOn))
+	}
+
+	if binding.Distinct {
+		return " DISTINCT"
+	}
+
+	return ""
+}
+
+func (b *Builder) buildSelectAggregate(aggregate *Aggregate, table string) string {
+	return fmt.Sprintf("%s ", b.buildAggregateExpression(aggregate, table))
+}
+
+// buildAggregateColumn is a function that will generate an aggregate select column with its alias
+func (b *Builder) buildAggregateColumn(aggregate *Aggregate, table string) string {
+	if "" == aggregate.Alias {
+		return b.buildAggregateExpression(aggregate, table)
+	}
+
+	return fmt.Sprintf(`%s AS "%s"`, b.buildAggregateExpression(aggregate, table), aggregate.Alias)
+}
+
+// buildWindowColumn is a function that will generate a window function select column with its OVER clause and alias
+func (b *Builder) buildWindowColumn(window *WindowFunction, table string) string {
+	var function string
+
+	switch {
+	case nil != window.Aggregate:
+		function = b.buildAggregateExpression(window.Aggregate, table)
+	case "" != window.Column:
+		function = fmt.Sprintf(`%s(%s, %d)`, window.Function, b.qualifyColumn(table, window.Column), window.Offset)
+	default:
+		function = fmt.Sprintf(`%s()`, window.Function)
+	}
+
+	var over []string
+
+	if nil != window.Window && len(window.Window.PartitionBy) > 0 {
+		over = append(over, fmt.Sprintf(`PARTITION BY %s`, b.mapColumnsToQuery(table, window.Window.PartitionBy)))
+	}
+
+	if nil != window.Window && len(window.Window.Orders) > 0 {
+		over = append(over, fmt.Sprintf(`ORDER BY %s`, b.buildOrderColumns(table, "", window.Window.Orders)))
+	}
+
+	query := fmt.Sprintf(`%s OVER (%s)`, function, strings.Join(over, " "))
+
+	if "" != window.Alias {
+		query = fmt.Sprintf(`%s AS "%s"`, query, window.Alias)
 	}
 
-	return fmt.Sprintf(`%v("%s"."%s") `, aggregateFn, table, column)
+	return query
 }
 
-func (b *Builder) buildQueryCondition(table string, conditions []*Condition) string {
+func (b *Builder) buildAggregateExpression(aggregate *Aggregate, table string) string {
+	if "*" == aggregate.Column {
+		return fmt.Sprintf(`%v("%s".%s)`, aggregate.AggregateFunc, table, aggregate.Column)
+	}
+
+	if aggregate.Distinct {
+		return fmt.Sprintf(`%v(DISTINCT "%s"."%s")`, aggregate.AggregateFunc, table, aggregate.Column)
+	}
+
+	return fmt.Sprintf(`%v("%s"."%s")`, aggregate.AggregateFunc, table, aggregate.Column)
+}
+
+func (b *Builder) buildQueryCondition(table string, prefix string, conditions []*Condition) string {
 	var query string
 
 	for i, w := range conditions {
+		expression := b.buildConditionExpression(table, prefix, i, w)
+
+		if 0 == i {
+			query = fmt.Sprintf(`%s%s `, query, expression)
+		} else {
+			query = fmt.Sprintf(`%s%s %s `, query, w.Connector, expression)
+		}
+	}
+
+	return strings.TrimSpace(query)
+}
+
+func (b *Builder) buildConditionExpression(table string, prefix string, i int, w *Condition) string {
+	key := b.conditionKey(prefix, i, w)
+
+	if len(w.Group) > 0 {
+		return fmt.Sprintf(`(%s)`, b.buildQueryCondition(table, b.groupPrefix(prefix, i), w.Group))
+	}
+
+	if w.IsRaw {
+		raw, _ := bindRaw(w.Raw, key, w.Value.(map[string]interface{}))
+
+		return fmt.Sprintf(`(%s)`, raw)
+	}
+
+	if sub, ok := w.Value.(*Query); ok {
+		subQuery := b.buildSubQuery(sub, b.subQueryPrefix(prefix, i))
+
 		switch w.Operator {
+		case EXISTS, NOT_EXISTS:
+			return fmt.Sprintf(`%s (%s)`, w.Operator, subQuery)
+		default:
+			return fmt.Sprintf(`"%s"."%s" %s (%s)`, table, w.Column, w.Operator, subQuery)
+		}
+	}
+
+	switch w.Operator {
+	case IN, NOT_IN:
+		return fmt.Sprintf(`"%s"."%s" %s (%s)`, table, w.Column, w.Operator, b.buildInNamed(key, w))
+	case BETWEEN, NOT_BETWEEN:
+		return fmt.Sprintf(`"%s"."%s" %s %s`, table, w.Column, w.Operator, b.buildBetweenNamed(key, w))
+	case IS_NULL, IS_NOT_NULL:
+		return fmt.Sprintf(`"%s"."%s" %s`, table, w.Column, w.Operator)
+	case ANY:
+		return fmt.Sprintf(`"%s"."%s" %s(:%s)`, table, w.Column, w.Operator, key)
+	default:
+		if w.IsCompareColumn {
+			return fmt.Sprintf(`"%s"."%s" %s "%s"."%s"`, table, w.Column, w.Operator, table, w.ColumnCompare)
+		}
+
+		if expression, ok := w.Value.(Expression); ok {
+			sql, _ := expression.bind(key)
+
+			return fmt.Sprintf(`"%s"."%s" %s %s`, table, w.Column, w.Operator, sql)
+		}
+
+		return fmt.Sprintf(`"%s"."%s" %s :%s`, table, w.Column, w.Operator, key)
+	}
+}
+
+// buildPayload is a function that will map every named parameter of the binding, including its subqueries, into payload
+func (b *Builder) buildPayload(binding Binding) map[string]interface{} {
+	payload := b.buildWithPayload(binding)
+
+	if nil != binding.From && nil != binding.From.Query {
+		b.mergePayload(payload, b.buildSubQueryPayload(binding.From.Query, binding.prefix+"f_"))
+	}
+
+	for i, sub := range binding.Selects {
+		b.mergePayload(payload, b.buildSubQueryPayload(sub.Query, fmt.Sprintf("%sc%d_", binding.prefix, i)))
+	}
+
+	for i, compound := range binding.Compounds {
+		b.mergePayload(payload, b.buildSubQueryPayload(compound.Query, fmt.Sprintf("%su%d_", binding.prefix, i)))
+	}
+
+	b.mergePayload(payload, b.buildConditionPayload(binding.prefix, binding.Conditions))
+
+	for i, v := range binding.Orders {
+		if "" != v.Raw {
+			_, args := bindRaw(v.Raw, b.orderKey(binding.prefix, i), v.Args)
+
+			b.mergePayload(payload, args)
+		}
+	}
+
+	for i, v := range binding.Havings {
+		_, args := bindRaw(v.Raw, b.conditionKey(binding.prefix+"h_", i, v), v.Value.(map[string]interface{}))
+
+		b.mergePayload(payload, args)
+	}
+
+	return payload
+}
+
+// buildConditionPayload is a function that will map the named parameters of the conditions, including grouped conditions and subqueries
+func (b *Builder) buildConditionPayload(prefix string, conditions []*Condition) map[string]interface{} {
+	payload := map[string]interface{}{}
+
+	for i, v := range conditions {
+		key := b.conditionKey(prefix, i, v)
+
+		if len(v.Group) > 0 {
+			b.mergePayload(payload, b.buildConditionPayload(b.groupPrefix(prefix, i), v.Group))
+
+			continue
+		}
+
+		if v.IsRaw {
+			_, args := bindRaw(v.Raw, key, v.Value.(map[string]interface{}))
+
+			b.mergePayload(payload, args)
+
+			continue
+		}
+
+		if sub, ok := v.Value.(*Query); ok {
+			b.mergePayload(payload, b.buildSubQueryPayload(sub, b.subQueryPrefix(prefix, i)))
+
+			continue
+		}
+
+		switch v.Operator {
 		case IN, NOT_IN:
-			if 0 == i {
-				query = fmt.Sprintf(`%s"%s"."%s" %s (%s) `, query, table, w.Column, w.Operator, b.buildInNamed(i, w))
-			} else {
-				query = fmt.Sprintf(`%s%s "%s"."%s" %s (%s) `, query, w.Connector, table, w.Column, w.Operator, b.buildInNamed(i, w))
-			}
+			payload = b.buildInValue(payload, key, v)
 		case BETWEEN, NOT_BETWEEN:
-			if 0 == i {
-				query = fmt.Sprintf(`%s"%s"."%s" %s %s `, query, table, w.Column, w.Operator, b.buildBetweenNamed(i, w))
-			} else {
-				query = fmt.Sprintf(`%s%s "%s"."%s" %s %s `, query, w.Connector, table, w.Column, w.Operator, b.buildBetweenNamed(i, w))
-			}
-		case IS_NULL, IS_NOT_NULL:
-			if 0 == i {
-				query = fmt.Sprintf(`%s"%s"."%s" %s `, query, table, w.Column, w.Operator)
-			} else {
-				query = fmt.Sprintf(`%s%s "%s"."%s" %s `, query, w.Connector, table, w.Column, w.Operator)
-			}
+			payload = b.buildBetweenValue(payload, key, v)
 		default:
-			if w.IsCompareColumn {
-				if 0 == i {
-					query = fmt.Sprintf(`%s"%s"."%s" %s "%s"."%s" `, query, table, w.Column, w.Operator, table, w.ColumnCompare)
-				} else {
-					query = fmt.Sprintf(`%s%s "%s"."%s" %s "%s"."%s" `, query, w.Connector, table, w.Column, w.Operator, table, w.ColumnCompare)
-				}
-			} else {
-				if 0 == i {
-					query = fmt.Sprintf(`%s"%s"."%s" %s :%d%s `, query, table, w.Column, w.Operator, i, w.Column)
-				} else {
-					query = fmt.Sprintf(`%s%s "%s"."%s" %s :%d%s `, query, w.Connector, table, w.Column, w.Operator, i, w.Column)
-				}
+			if expression, ok := v.Value.(Expression); ok {
+				_, args := expression.bind(key)
+
+				b.mergePayload(payload, args)
+			} else if !v.IsCompareColumn {
+				payload[key] = v.Value
 			}
 		}
 	}
 
-	return query
+	return payload
+}
+
+func (b *Builder) buildSubQueryPayload(sub *Query, prefix string) map[string]interface{} {
+	binding := sub.Binding
+	binding.prefix = prefix
+
+	return b.buildPayload(binding)
 }
 
-func (b *Builder) buildInNamed(prefix int, condition *Condition) string {
+func (b *Builder) mergePayload(payload map[string]interface{}, values map[string]interface{}) {
+	for key, value := range values {
+		payload[key] = value
+	}
+}
+
+// buildExpressionPayload is a function that will map the arguments of every raw expression of the values into payload
+func (b *Builder) buildExpressionPayload(prefix string, values map[string]interface{}) map[string]interface{} {
+	payload := map[string]interface{}{}
+
+	for col, value := range values {
+		if expression, ok := value.(Expression); ok {
+			_, args := expression.bind(fmt.Sprintf("%s%s", prefix, col))
+
+			b.mergePayload(payload, args)
+		}
+	}
+
+	return payload
+}
+
+func (b *Builder) copyPayload(values map[string]interface{}) map[string]interface{} {
+	payload := make(map[string]interface{}, len(values))
+
+	b.mergePayload(payload, values)
+
+	return payload
+}
+
+// conditionKey is a function that returns the named parameter of a condition
+func (b *Builder) conditionKey(prefix string, i int, condition *Condition) string {
+	return fmt.Sprintf("%s%d%s", prefix, i, condition.Column)
+}
+
+func (b *Builder) orderKey(prefix string, i int) string {
+	return fmt.Sprintf("%so%d", prefix, i)
+}
+
+func (b *Builder) groupPrefix(prefix string, i int) string {
+	return fmt.Sprintf("%s%dg_", prefix, i)
+}
+
+func (b *Builder) subQueryPrefix(prefix string, i int) string {
+	return fmt.Sprintf("%s%ds_", prefix, i)
+}
+
+func (b *Builder) buildInNamed(key string, condition *Condition) string {
 	var bind string
 
 	length := reflect.ValueOf(condition.Value).Len()
 
 	for i := 0; i < length; i++ {
 		if i == length-1 {
-			bind = fmt.Sprintf("%s:%d%s_in_%d", bind, prefix, condition.Column, i)
+			bind = fmt.Sprintf("%s:%s_in_%d", bind, key, i)
 		} else {
-			bind = fmt.Sprintf("%s:%d%s_in_%d,", bind, prefix, condition.Column, i)
+			bind = fmt.Sprintf("%s:%s_in_%d,", bind, key, i)
 		}
 	}
 
 	return bind
 }
 
-func (b *Builder) buildInValue(payload map[string]interface{}, prefix int, condition *Condition) map[string]interface{} {
+func (b *Builder) buildInValue(payload map[string]interface{}, key string, condition *Condition) map[string]interface{} {
 	vals := reflect.ValueOf(condition.Value)
 
 	for i := 0; i < vals.Len(); i++ {
-		key := fmt.Sprintf("%d%s_in_%d", prefix, condition.Column, i)
-
-		payload[key] = vals.Index(i).Interface()
+		payload[fmt.Sprintf("%s_in_%d", key, i)] = vals.Index(i).Interface()
 	}
 
 	return payload
 }
 
-func (b *Builder) buildBetweenNamed(prefix int, condition *Condition) string {
+func (b *Builder) buildBetweenNamed(key string, condition *Condition) string {
 	var bind string
 
 	length := reflect.ValueOf(condition.Value).Len()
 
 	if length == 2 {
-		bind = fmt.Sprintf("%s:%d%s0 AND :%d%s1", bind, prefix, condition.Column, prefix, condition.Column)
+		bind = fmt.Sprintf("%s:%s0 AND :%s1", bind, key, key)
 	}
 
 	return bind
 }
 
-func (b *Builder) buildBetweenValue(payload map[string]interface{}, prefix int, condition *Condition) map[string]interface{} {
+func (b *Builder) buildBetweenValue(payload map[string]interface{}, key string, condition *Condition) map[string]interface{} {
 	vals := reflect.ValueOf(condition.Value)
 
 	for i := 0; i < vals.Len(); i++ {
-		key := fmt.Sprintf("%d%s%d", prefix, condition.Column, i)
-
-		payload[key] = vals.Index(i).Interface()
+		payload[fmt.Sprintf("%s%d", key, i)] = vals.Index(i).Interface()
 	}
 
 	return payload
 }
 
-func (b *Builder) buildOrderColumns(table string, columns []string) string {
-	return b.mapColumnsToQuery(table, columns)
+func (b *Builder) buildOrderColumns(table string, prefix string, orders []*Order) string {
+	var cols []string
+
+	for i, order := range orders {
+		if "" != order.Raw {
+			raw, _ := bindRaw(order.Raw, b.orderKey(prefix, i), order.Args)
+
+			cols = append(cols, raw)
+
+			continue
+		}
+
+		for _, col := range order.Columns {
+			column := fmt.Sprintf(`"%s"`, col)
+
+			if "" != table {
+				column = fmt.Sprintf(`"%s"."%s"`, table, col)
+			}
+
+			if "" != order.Nulls {
+				cols = append(cols, fmt.Sprintf(`%s %s %s`, column, order.Direction, order.Nulls))
+			} else {
+				cols = append(cols, fmt.Sprintf(`%s %s`, column, order.Direction))
+			}
+		}
+	}
+
+	return strings.Join(cols, ", ")
 }
 
 func (b *Builder) buildGroupByColumns(table string, columns []string) string {
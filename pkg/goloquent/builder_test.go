@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -84,3 +85,514 @@ func TestBuilder_DropTable(t *testing.T) {
 		require.Equal(t, expectedQuery, query)
 	})
 }
+
+func TestBuilder_Select(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("SelectOrderLimitOffset", func(t *testing.T) {
+		query := DB(nil).Use(genreModel()).
+			Where("name", ILIKE, "%bulk%").
+			OrderBy(ASC, "name").
+			OrderBy(DESC, "id").
+			Take(10).
+			Skip(20)
+
+		expectedQuery := `SELECT "genres"."id", "genres"."name" FROM "genres" WHERE "genres"."name" ILIKE :0name ORDER BY "genres"."name" ASC, "genres"."id" DESC LIMIT 10 OFFSET 20 `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+
+	t.Run("SelectOrderRawAndNulls", func(t *testing.T) {
+		query := DB(nil).Use(genreModel()).
+			OrderBy(ASC, "name").NullsLast().
+			Latest("id").
+			InRandomOrder()
+
+		expectedQuery := `SELECT "genres"."id", "genres"."name" FROM "genres" ORDER BY "genres"."name" ASC NULLS LAST, "genres"."id" DESC, RANDOM() `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+}
+
+type movie struct {
+	Model
+	ID      int64  `db:"id"`
+	Title   string `db:"title"`
+	GenreID int64  `db:"genre_id"`
+	Rating  int64  `db:"rating"`
+}
+
+func movieModel() *movie {
+	return &movie{
+		Model: AutoIncrementModel("movies", "id", false, false),
+	}
+}
+
+func TestBuilder_SubQuery(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("WhereInSubQuery", func(t *testing.T) {
+		top := DB(nil).Use(genreModel()).
+			Select("id").
+			Where("name", ILIKE, "%a%").
+			OrderBy(DESC, "id").
+			Take(10)
+
+		query := DB(nil).Use(movieModel()).
+			Where("title", LIKE, "%x%").
+			WhereIn("genre_id", top).
+			WhereExists(DB(nil).Use(genreModel()).Select("id").Where("name", EQUAL, "Action"))
+
+		expectedQuery := `SELECT "movies"."id", "movies"."title", "movies"."genre_id", "movies"."rating" FROM "movies" WHERE "movies"."title" LIKE :0title AND "movies"."genre_id" IN (SELECT "genres"."id" FROM "genres" WHERE "genres"."name" ILIKE :1s_0name ORDER BY "genres"."id" DESC LIMIT 10) AND EXISTS (SELECT "genres"."id" FROM "genres" WHERE "genres"."name" = :2s_0name) `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+		require.Equal(t, map[string]interface{}{
+			"0title":   "%x%",
+			"1s_0name": "%a%",
+			"2s_0name": "Action",
+		}, query.mapConditionPayload())
+	})
+
+	t.Run("FromAndSelectSubQuery", func(t *testing.T) {
+		latest := DB(nil).Use(movieModel()).Where("rating", GREATER_THAN, 3)
+		count := DB(nil).Use(genreModel()).Select("name").Where("id", EQUAL, 1)
+
+		query := DB(nil).Use(movieModel()).
+			FromSub(latest, "rated").
+			SelectSub(count, "title").
+			Where("genre_id", EQUAL, 1)
+
+		expectedQuery := `SELECT "rated"."id", "rated"."genre_id", "rated"."rating", (SELECT "genres"."name" FROM "genres" WHERE "genres"."id" = :c0_0id) AS "title" FROM (SELECT "movies"."id", "movies"."title", "movies"."genre_id", "movies"."rating" FROM "movies" WHERE "movies"."rating" > :f_0rating) AS "rated" WHERE "rated"."genre_id" = :0genre_id `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+		require.Equal(t, map[string]interface{}{
+			"c0_0id":    1,
+			"f_0rating": 3,
+			"0genre_id": 1,
+		}, query.mapConditionPayload())
+	})
+}
+
+func TestBuilder_Raw(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("WhereRawAndHavingRaw", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).
+			Select("genre_id").
+			Where("rating", LESS_THAN, Raw("now()")).
+			WhereRaw("date_trunc('day', created_at) = :d", map[string]interface{}{"d": "2019-01-01"}).
+			OrWhereRaw("rating > ?", 3).
+			GroupBy("genre_id").
+			HavingRaw("COUNT(*) > ?", 5)
+
+		expectedQuery := `SELECT "movies"."genre_id" FROM "movies" WHERE "movies"."rating" < now() AND (date_trunc('day', created_at) = :1_d) OR (rating > :2_0) GROUP BY "movies"."genre_id" HAVING (COUNT(*) > :h_0_0) `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+		require.Equal(t, map[string]interface{}{
+			"1_d":   "2019-01-01",
+			"2_0":   3,
+			"h_0_0": 5,
+		}, query.mapConditionPayload())
+	})
+
+	t.Run("UpdateColumns", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).Where("genre_id", EQUAL, 1)
+
+		expectedQuery := `UPDATE movies SET "rating"=:u_rating, "title"=upper(title) WHERE "movies"."genre_id" = :0genre_id;`
+
+		require.Equal(t, expectedQuery, builder.BuildUpdateColumns(query.Model, query.Binding, map[string]interface{}{
+			"rating": 5,
+			"title":  Raw("upper(title)"),
+		}))
+	})
+}
+
+func TestBuilder_CTE(t *testing.T) {
+	builder := NewBuilder()
+
+	anchor := DB(nil).Use(genreModel()).Where("id", EQUAL, 7)
+	recursive := DB(nil).Use(genreModel()).
+		WhereRaw(`"genres"."id" IN (SELECT "id" FROM "ancestors")`).
+		Where("name", NOT_EQUAL, "Root")
+
+	t.Run("SelectWithRecursive", func(t *testing.T) {
+		query := DB(nil).Use(genreModel()).
+			WithRecursive("ancestors", anchor, recursive).
+			From("ancestors").
+			OrderBy(ASC, "id")
+
+		expectedQuery := `WITH RECURSIVE "ancestors" AS (SELECT "genres"."id", "genres"."name" FROM "genres" WHERE "genres"."id" = :w0_0id UNION ALL SELECT "genres"."id", "genres"."name" FROM "genres" WHERE ("genres"."id" IN (SELECT "id" FROM "ancestors")) AND "genres"."name" != :w0r_1name) SELECT "ancestors"."id", "ancestors"."name" FROM "ancestors" ORDER BY "ancestors"."id" ASC `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+		require.Equal(t, map[string]interface{}{
+			"w0_0id":    7,
+			"w0r_1name": "Root",
+		}, query.mapConditionPayload())
+	})
+
+	t.Run("DeleteWith", func(t *testing.T) {
+		query := DB(nil).Use(genreModel()).
+			WithCTE("stale", DB(nil).Use(genreModel()).Select("id").WhereNull("name")).
+			WhereRaw(`"genres"."id" IN (SELECT "id" FROM "stale")`)
+
+		expectedQuery := `WITH "stale" AS (SELECT "genres"."id" FROM "genres" WHERE "genres"."name" IS NULL) DELETE FROM genres WHERE ("genres"."id" IN (SELECT "id" FROM "stale"));`
+
+		require.Equal(t, expectedQuery, builder.BuildDelete(query.Model, query.Binding))
+	})
+}
+
+func TestBuilder_Compound(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("UnionAllOrderLimit", func(t *testing.T) {
+		archived := DB(nil).Use(genreModel()).From("archived_genres").Where("name", ILIKE, "%a%")
+
+		query := DB(nil).Use(genreModel()).
+			Where("name", ILIKE, "%b%").
+			UnionAll(archived).
+			ExceptQuery(DB(nil).Use(genreModel()).Where("id", EQUAL, 1)).
+			OrderBy(ASC, "name").
+			Take(5)
+
+		expectedQuery := `(SELECT "genres"."id", "genres"."name" FROM "genres" WHERE "genres"."name" ILIKE :0name) UNION ALL (SELECT "archived_genres"."id", "archived_genres"."name" FROM "archived_genres" WHERE "archived_genres"."name" ILIKE :u0_0name) EXCEPT (SELECT "genres"."id", "genres"."name" FROM "genres" WHERE "genres"."id" = :u1_0id) ORDER BY "name" ASC LIMIT 5 `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+		require.Equal(t, map[string]interface{}{
+			"0name":    "%b%",
+			"u0_0name": "%a%",
+			"u1_0id":   1,
+		}, query.mapConditionPayload())
+	})
+}
+
+func TestBuilder_Json(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("WhereJson", func(t *testing.T) {
+		query := DB(nil).Use(genreModel()).
+			WhereJsonPath("meta->settings->>theme", EQUAL, "dark").
+			WhereJsonContains("meta", map[string]interface{}{"featured": true}).
+			OrWhereJsonLength("meta->tags", GREATER_THAN, 2)
+
+		expectedQuery := `SELECT "genres"."id", "genres"."name" FROM "genres" WHERE ("genres"."meta"->'settings'->>'theme' = :0_value) AND ("genres"."meta" @> CAST(:1_value AS JSONB)) OR (jsonb_array_length(CAST("genres"."meta"->'tags' AS JSONB)) > :2_value) `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+		require.Equal(t, map[string]interface{}{
+			"0_value": "dark",
+			"1_value": `{"featured":true}`,
+			"2_value": 2,
+		}, query.mapConditionPayload())
+	})
+}
+
+func TestBuilder_Array(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("WhereArray", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).
+			WhereArrayContains("tags", []string{"classic"}).
+			OrWhereArrayOverlaps("tags", []string{"noir", "crime"}).
+			WhereAny("id", []int64{1, 2, 3})
+
+		expectedQuery := `SELECT "movies"."id", "movies"."title", "movies"."genre_id", "movies"."rating" FROM "movies" WHERE "movies"."tags" @> :0tags OR "movies"."tags" && :1tags AND "movies"."id" = ANY(:2id) `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+		require.Equal(t, pq.Array([]int64{1, 2, 3}), query.mapConditionPayload()["2id"])
+	})
+
+	t.Run("CreateArrayColumn", func(t *testing.T) {
+		schema := Create("movies", func(table *Schema) {
+			table.Array("tags", DT_TEXT)
+		})
+
+		require.Equal(t, "CREATE TABLE IF NOT EXISTS movies ( tags TEXT[] );\n", builder.BuildCreateTable(schema))
+	})
+}
+
+func TestBuilder_FullText(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("WhereFullTextOrderByRank", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).
+			WhereFullText([]string{"title", "director"}, "space odyssey", FullTextOptions{Language: "simple", Mode: SEARCH_WEB}).
+			OrderByRank([]string{"title"}, "space")
+
+		expectedQuery := `SELECT "movies"."id", "movies"."title", "movies"."genre_id", "movies"."rating" FROM "movies" WHERE (to_tsvector('simple', coalesce("movies"."title", '') || ' ' || coalesce("movies"."director", '')) @@ websearch_to_tsquery('simple', :0_term)) ORDER BY ts_rank(to_tsvector('english', "movies"."title"), plainto_tsquery('english', :o0_term)) DESC `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+		require.Equal(t, map[string]interface{}{
+			"0_term":  "space odyssey",
+			"o0_term": "space",
+		}, query.mapConditionPayload())
+	})
+
+	t.Run("CreateFullTextIndex", func(t *testing.T) {
+		schema := Create("movies", func(table *Schema) {
+			table.String("title")
+			table.TsVector("search")
+			table.FullTextIndex("title", "director").Language("simple")
+			table.FullTextIndex("search").Vector()
+		})
+
+		expectedQuery := "CREATE TABLE IF NOT EXISTS movies ( title VARCHAR,search TSVECTOR );\n"
+		expectedQuery = fmt.Sprintf("%sCREATE INDEX IF NOT EXISTS movies_title_director_fulltext ON movies USING GIN (to_tsvector('simple', coalesce(\"title\", '') || ' ' || coalesce(\"director\", '')));\n", expectedQuery)
+		expectedQuery = fmt.Sprintf("%sCREATE INDEX IF NOT EXISTS movies_search_fulltext ON movies USING GIN (\"search\");\n", expectedQuery)
+
+		require.Equal(t, expectedQuery, builder.BuildCreateTable(schema))
+	})
+}
+
+func TestBuilder_Lock(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("LockForUpdateSkipLocked", func(t *testing.T) {
+		query := DB(nil).Use(genreModel()).
+			WhereNull("name").
+			OrderBy(ASC, "id").
+			Take(5).
+			SkipLocked()
+
+		expectedQuery := `SELECT "genres"."id", "genres"."name" FROM "genres" WHERE "genres"."name" IS NULL ORDER BY "genres"."id" ASC LIMIT 5 FOR UPDATE SKIP LOCKED `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+
+	t.Run("LockWithoutTransaction", func(t *testing.T) {
+		_, err := DB(nil).Use(genreModel()).SharedLock().NoWait().Get()
+
+		require.Equal(t, ErrLockWithoutTransaction, err)
+	})
+
+	t.Run("LockUnsupported", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		queries := map[string]func(q *Query) *Query{
+			"Aggregate": func(q *Query) *Query { return q.SelectAggregates(Count("*").As("total")) },
+			"Distinct":  func(q *Query) *Query { return q.Distinct() },
+			"GroupBy":   func(q *Query) *Query { return q.Select("name").GroupBy("name") },
+			"Union":     func(q *Query) *Query { return q.Union(DB(db).Use(genreModel())) },
+		}
+
+		for name, build := range queries {
+			query := DB(db).Use(genreModel()).BeginTransaction()
+
+			_, err := build(query).LockForUpdate().Get()
+
+			query.Rollback()
+
+			require.Equal(t, ErrLockUnsupported, err, name)
+		}
+
+		require.Empty(t, fake.Statements)
+	})
+}
+
+func TestBuilder_Distinct(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("Distinct", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).Distinct().Select("genre_id")
+
+		expectedQuery := `SELECT DISTINCT "movies"."genre_id" FROM "movies" `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+
+	t.Run("DistinctOn", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).
+			Select("id", "genre_id", "rating").
+			DistinctOn("genre_id").
+			OrderBy(ASC, "genre_id").
+			OrderBy(DESC, "rating")
+
+		expectedQuery := `SELECT DISTINCT ON ("movies"."genre_id") "movies"."id", "movies"."genre_id", "movies"."rating" FROM "movies" ORDER BY "movies"."genre_id" ASC, "movies"."rating" DESC `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+
+	t.Run("CountDistinct", func(t *testing.T) {
+		query := DB(nil).Use(movieModel())
+		query.Binding.Aggregate = newAggregate(COUNT, "genre_id")
+		query.Binding.Aggregate.Distinct = true
+
+		expectedQuery := `SELECT COUNT(DISTINCT "movies"."genre_id") FROM "movies" `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+}
+
+func TestBuilder_Aggregates(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("GroupedAggregates", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).
+			SelectAggregates(Count("*").As("n"), Avg("rating").As("avg")).
+			GroupBy("genre_id").
+			OrderBy(DESC, "genre_id")
+
+		expectedQuery := `SELECT "movies"."genre_id", COUNT("movies".*) AS "n", AVG("movies"."rating") AS "avg" FROM "movies" GROUP BY "movies"."genre_id" ORDER BY "movies"."genre_id" DESC `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+
+	t.Run("SelectedColumnsWithAggregates", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).
+			Select("genre_id").
+			SelectAggregates(Max("rating").As("best"), Min("rating")).
+			Where("rating", GREATER_THAN, 1).
+			GroupBy("genre_id")
+
+		expectedQuery := `SELECT "movies"."genre_id", MAX("movies"."rating") AS "best", MIN("movies"."rating") FROM "movies" WHERE "movies"."rating" > :0rating GROUP BY "movies"."genre_id" `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+}
+
+func TestBuilder_Window(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("RowNumber", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).
+			Select("id", "genre_id").
+			SelectWindow(RowNumber().Over(PartitionBy("genre_id").OrderBy("rating", DESC)).As("rank"))
+
+		expectedQuery := `SELECT "movies"."id", "movies"."genre_id", ROW_NUMBER() OVER (PARTITION BY "movies"."genre_id" ORDER BY "movies"."rating" DESC) AS "rank" FROM "movies" `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+
+	t.Run("LagAndRunningTotal", func(t *testing.T) {
+		query := DB(nil).Use(movieModel()).
+			Select("id").
+			SelectWindow(
+				Lag("rating", 1).Over(PartitionBy().OrderBy("id", ASC)).As("previous"),
+				Sum("rating").Over(PartitionBy("genre_id").OrderBy("id", ASC)).As("running"),
+				DenseRank().Over(PartitionBy().OrderBy("rating", DESC)),
+			)
+
+		expectedQuery := `SELECT "movies"."id", LAG("movies"."rating", 1) OVER (ORDER BY "movies"."id" ASC) AS "previous", SUM("movies"."rating") OVER (PARTITION BY "movies"."genre_id" ORDER BY "movies"."id" ASC) AS "running", DENSE_RANK() OVER (ORDER BY "movies"."rating" DESC) FROM "movies" `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+}
+
+func TestBuilder_Exists(t *testing.T) {
+	builder := NewBuilder()
+
+	query := DB(nil).Use(genreModel()).Where("name", EQUAL, "Drama")
+
+	expectedQuery := `SELECT EXISTS(SELECT "genres"."id", "genres"."name" FROM "genres" WHERE "genres"."name" = :0name) `
+
+	require.Equal(t, expectedQuery, builder.BuildExists(query.Model, query.Binding))
+}
+
+func TestBuilder_Upsert(t *testing.T) {
+	builder := NewBuilder()
+
+	model := movieModel()
+	model.Title = "Heat"
+	model.GenreID = 1
+	model.Rating = 9
+
+	expectedQuery := `INSERT INTO movies ("title", "genre_id", "rating") VALUES (:title, :genre_id, :rating) ON CONFLICT ("genre_id", "title") DO UPDATE SET "rating"=EXCLUDED."rating" RETURNING "id", "title", "genre_id", "rating";
+`
+
+	require.Equal(t, expectedQuery, builder.BuildUpsert(model, []string{"genre_id", "title"}, []string{"rating"}))
+}
+
+type movieGenre struct {
+	Model
+	MovieID int64 `db:"movie_id"`
+	GenreID int64 `db:"genre_id"`
+	Rank    int64 `db:"rank"`
+}
+
+func movieGenreModel() *movieGenre {
+	return &movieGenre{
+		Model: CompositeKeyModel("movie_genres", []string{"movie_id", "genre_id"}, false, false),
+	}
+}
+
+func TestBuilder_CompositeKey(t *testing.T) {
+	builder := NewBuilder()
+
+	t.Run("Update", func(t *testing.T) {
+		expectedQuery := `UPDATE movie_genres SET "rank"=:rank WHERE "movie_id"=:movie_id AND "genre_id"=:genre_id;`
+
+		require.Equal(t, expectedQuery, builder.BuildUpdate(movieGenreModel(), Binding{}, []string{"rank"}))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		expectedQuery := `DELETE FROM movie_genres WHERE "movie_id"=:movie_id AND "genre_id"=:genre_id;`
+
+		require.Equal(t, expectedQuery, builder.BuildDelete(movieGenreModel(), Binding{}))
+	})
+
+	t.Run("Find", func(t *testing.T) {
+		query := DB(nil).Use(movieGenreModel())
+
+		require.NoError(t, query.wherePrimaryKey(map[string]interface{}{"movie_id": 1, "genre_id": 2}))
+
+		expectedQuery := `SELECT "movie_genres"."movie_id", "movie_genres"."genre_id", "movie_genres"."rank" FROM "movie_genres" WHERE "movie_genres"."movie_id" = :0movie_id AND "movie_genres"."genre_id" = :1genre_id `
+
+		require.Equal(t, expectedQuery, builder.BuildSelect(query.Model, query.Binding))
+	})
+
+	t.Run("FindWithoutEveryKey", func(t *testing.T) {
+		_, err := DB(nil).Use(movieGenreModel()).Find(1)
+
+		require.Equal(t, ErrCompositeKey, err)
+	})
+}
+
+func TestBuilder_FindMany(t *testing.T) {
+	t.Run("EmptyKeys", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		result, err := DB(db).Use(genreModel()).FindMany([]int64{})
+
+		require.NoError(t, err)
+		require.Empty(t, result)
+		require.Equal(t, `SELECT "genres"."id", "genres"."name" FROM "genres" WHERE (FALSE) `, fake.Statements[0])
+	})
+
+	t.Run("ScalarKeys", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		_, err := DB(db).Use(genreModel()).FindMany(5)
+
+		require.Equal(t, ErrKeysNotSlice, err)
+		require.Empty(t, fake.Statements)
+	})
+
+	t.Run("EmptyCompositeKeys", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		_, err := DB(db).Use(movieGenreModel()).FindMany([]map[string]interface{}{})
+
+		require.NoError(t, err)
+		require.Contains(t, fake.Statements[0], `WHERE (FALSE) `)
+	})
+}
+
+func TestBuilder_UuidPrimary(t *testing.T) {
+	builder := NewBuilder()
+
+	schema := Create("accounts", func(table *Schema) {
+		table.UUID("id").Primary()
+		table.String("name")
+	})
+
+	require.Equal(t, "CREATE TABLE IF NOT EXISTS accounts ( id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),name VARCHAR );\n", builder.BuildCreateTable(schema))
+}
+
+func TestBuilder_Version(t *testing.T) {
+	builder := NewBuilder()
+
+	schema := Create("genres", func(table *Schema) {
+		table.String("name")
+		table.Version()
+	})
+
+	require.Equal(t, "CREATE TABLE IF NOT EXISTS genres ( name VARCHAR,version BIGINT NOT NULL DEFAULT '1' );\n", builder.BuildCreateTable(schema))
+}
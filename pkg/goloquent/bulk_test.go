@@ -0,0 +1,81 @@
+package goloquent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func genres(count int) []*genre {
+	models := make([]*genre, count)
+
+	for i := range models {
+		models[i] = genreModel()
+	}
+
+	return models
+}
+
+func TestBulk_Batches(t *testing.T) {
+	t.Run("ParameterLimit", func(t *testing.T) {
+		query := DB(nil).Use(genreModel())
+
+		models, err := query.bulkModels(genres(MaxBindParameters + 1))
+		require.NoError(t, err)
+
+		batches := query.bulkBatches(models)
+
+		require.Len(t, batches, 2)
+		require.Len(t, batches[0], MaxBindParameters)
+		require.Len(t, batches[1], 1)
+	})
+
+	t.Run("BatchSize", func(t *testing.T) {
+		query := DB(nil).Use(genreModel()).BatchSize(2)
+
+		models, err := query.bulkModels(genres(5))
+		require.NoError(t, err)
+
+		batches := query.bulkBatches(models)
+
+		require.Len(t, batches, 3)
+		require.Len(t, batches[2], 1)
+	})
+
+	t.Run("NotModels", func(t *testing.T) {
+		_, err := DB(nil).Use(genreModel()).bulkModels([]int{1, 2})
+
+		require.Error(t, err)
+	})
+}
+
+func TestBulk_Insert(t *testing.T) {
+	var statements []Statement
+
+	query := DB(nil).Intercept(func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+		statements = append(statements, stmt)
+
+		return Result{}, nil
+	})
+
+	models := genres(2)
+
+	for _, model := range models {
+		model.Timestamp = true
+	}
+
+	_, err := query.Use(models[0]).BulkInsert(models)
+
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+	require.Equal(t, "INSERT INTO genres (\"name\", \"created_at\", \"updated_at\") VALUES (:0name, :0created_at, :0updated_at), (:1name, :1created_at, :1updated_at) RETURNING \"id\";\n", statements[0].Query)
+
+	payload := statements[0].Args.(map[string]interface{})
+
+	for _, key := range []string{"0created_at", "0updated_at", "1created_at", "1updated_at"} {
+		require.IsType(t, &time.Time{}, payload[key])
+		require.NotNil(t, payload[key])
+	}
+}
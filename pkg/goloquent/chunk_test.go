@@ -0,0 +1,129 @@
+package goloquent
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// genreRows is a function that answers genre queries with the ids greater than the last keyset argument, limited to limit rows
+func genreRows(ids []int64, limit int) fakeResponder {
+	return func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		var last int64
+
+		if strings.Contains(query, `"genres"."id" >`) {
+			last, _ = strconv.ParseInt(fmt.Sprint(args[len(args)-1]), 10, 64)
+		}
+
+		var rows [][]driver.Value
+
+		for _, id := range ids {
+			if id > last && len(rows) < limit {
+				rows = append(rows, []driver.Value{id, "genre"})
+			}
+		}
+
+		return []string{"id", "name"}, rows
+	}
+}
+
+func TestChunk_ChunkById(t *testing.T) {
+	db, fake := newFakeDB(t, genreRows([]int64{1, 2, 3, 4, 5}, 2))
+
+	var batches [][]int64
+
+	err := DB(db).Use(genreModel()).
+		Where("name", EQUAL, "a").
+		OrWhere("name", EQUAL, "b").
+		ChunkById(2, func(batch interface{}) error {
+			var ids []int64
+
+			for _, model := range batch.([]*genre) {
+				ids = append(ids, model.ID)
+			}
+
+			batches = append(batches, ids)
+
+			return nil
+		})
+
+	require.NoError(t, err)
+	require.Equal(t, [][]int64{{1, 2}, {3, 4}, {5}}, batches)
+	require.Len(t, fake.Statements, 3)
+	require.Equal(t, `SELECT "genres"."id", "genres"."name" FROM "genres" WHERE ("genres"."name" = $1 OR "genres"."name" = $2) ORDER BY "genres"."id" ASC LIMIT 2 `, fake.Statements[0])
+	require.Equal(t, `SELECT "genres"."id", "genres"."name" FROM "genres" WHERE ("genres"."name" = $1 OR "genres"."name" = $2) AND (("genres"."id" > $3)) ORDER BY "genres"."id" ASC LIMIT 2 `, fake.Statements[1])
+	require.Equal(t, int64(4), fake.Args[2][2])
+}
+
+func TestChunk_ChunkByCompositeKey(t *testing.T) {
+	keys := [][2]int64{{1, 1}, {1, 2}, {1, 3}, {2, 1}}
+
+	db, fake := newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		var last [2]int64
+
+		if strings.Contains(query, `"movie_genres"."movie_id" >`) {
+			last[0], _ = strconv.ParseInt(fmt.Sprint(args[len(args)-2]), 10, 64)
+			last[1], _ = strconv.ParseInt(fmt.Sprint(args[len(args)-1]), 10, 64)
+		}
+
+		var rows [][]driver.Value
+
+		for _, key := range keys {
+			after := key[0] > last[0] || (key[0] == last[0] && key[1] > last[1])
+
+			if after && len(rows) < 2 {
+				rows = append(rows, []driver.Value{key[0], key[1], int64(0)})
+			}
+		}
+
+		return []string{"movie_id", "genre_id", "rank"}, rows
+	})
+
+	var seen [][2]int64
+
+	err := DB(db).Use(movieGenreModel()).ChunkById(2, func(batch interface{}) error {
+		for _, model := range batch.([]*movieGenre) {
+			seen = append(seen, [2]int64{model.MovieID, model.GenreID})
+		}
+
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, keys, seen)
+	require.Contains(t, fake.Statements[1], `WHERE (("movie_genres"."movie_id" > $1) OR ("movie_genres"."movie_id" = $2 AND "movie_genres"."genre_id" > $3)) ORDER BY "movie_genres"."movie_id" ASC, "movie_genres"."genre_id" ASC LIMIT 2 `)
+}
+
+func TestChunk_ChunkByIdWithOffset(t *testing.T) {
+	db, fake := newFakeDB(t, genreRows([]int64{1, 2, 3, 4, 5}, 2))
+
+	err := DB(db).Use(genreModel()).Skip(1).ChunkById(2, func(batch interface{}) error {
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, fake.Statements, 3)
+
+	for _, statement := range fake.Statements {
+		require.NotContains(t, statement, "OFFSET")
+	}
+}
+
+func TestChunk_Chunk(t *testing.T) {
+	db, _ := newFakeDB(t, genreRows([]int64{1, 2, 3, 4, 5}, 5))
+
+	var sizes []int
+
+	err := DB(db).Use(genreModel()).Chunk(2, func(batch interface{}) error {
+		sizes = append(sizes, len(batch.([]*genre)))
+
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 2, 1}, sizes)
+}
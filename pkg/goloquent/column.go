@@ -40,6 +40,18 @@ func (c *Column) PrimaryKey() *Column {
 	return c
 }
 
+// Primary is a setter for a not null primary key, UUID columns default to gen_random_uuid()
+func (c *Column) Primary() *Column {
+	c.PrimaryKey()
+	c.NotNull()
+
+	if DT_UUID == c.dataType && nil == c.defaultValue {
+		c.defaultValue = Raw("gen_random_uuid()")
+	}
+
+	return c
+}
+
 // NotNull is a setter for nullable
 func (c *Column) NotNull() *Column {
 	c.nullable = false
@@ -65,7 +77,7 @@ func (c *Column) AutoIncrement() *Column {
 	return c
 }
 
-// DefaultValue is a setter for default value
+// DefaultValue is a setter for default value, Raw expressions are written as is
 func (c *Column) DefaultValue(v interface{}) *Column {
 	c.defaultValue = v
 
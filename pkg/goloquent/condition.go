@@ -8,6 +8,9 @@ type Condition struct {
 	Value           interface{}
 	IsCompareColumn bool
 	ColumnCompare   string
+	IsRaw           bool
+	Raw             string
+	Group           []*Condition
 }
 
 func newCondition(connector Connector, column string, operator Operator, value interface{}) *Condition {
@@ -30,3 +33,28 @@ func newCompareColumnCondition(connector Connector, targetColumn string, operato
 		ColumnCompare:   sourceColumn,
 	}
 }
+
+func newRawCondition(connector Connector, raw string, args map[string]interface{}) *Condition {
+	return &Condition{
+		Connector: connector,
+		Value:     args,
+		IsRaw:     true,
+		Raw:       raw,
+	}
+}
+
+func newGroupCondition(connector Connector, conditions []*Condition) *Condition {
+	return &Condition{
+		Connector: connector,
+		Group:     conditions,
+	}
+}
+
+// groupConditions is a function that will wrap the conditions in parentheses so that appended conditions apply to all of them
+func groupConditions(conditions []*Condition) []*Condition {
+	if len(conditions) < 2 {
+		return append([]*Condition{}, conditions...)
+	}
+
+	return []*Condition{newGroupCondition(AND, conditions)}
+}
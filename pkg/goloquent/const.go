@@ -18,6 +18,21 @@ type Connector string
 // OrderDirection is a replica of string type that used for specify order direction
 type OrderDirection string
 
+// NullsOrder is a replica of string type that used for specify null values position on ordering
+type NullsOrder string
+
+// SetOperator is a replica of string type that used for combining the result of select statements
+type SetOperator string
+
+// SearchMode is a replica of string type that used for specify the function which parses full text search term
+type SearchMode string
+
+// LockMode is a replica of string type that used for specify row locking clause
+type LockMode string
+
+// LockWait is a replica of string type that used for specify how row locking waits for locked rows
+type LockWait string
+
 // AggregateFunction is a replica of string type that used for store Aggregate Function
 type AggregateFunction string
 
@@ -44,11 +59,14 @@ const (
 	DT_TEXT        DataType = "TEXT"
 	DT_UUID        DataType = "UUID"
 	DT_JSON        DataType = "JSON"
+	DT_JSONB       DataType = "JSONB"
 	DT_BOOL        DataType = "BOOLEAN"
 	DT_DATE        DataType = "DATE"
 	DT_TIME        DataType = "TIME"
 	DT_TIMESTAMP   DataType = "TIMESTAMP"
 	DT_TIMESTAMPTZ DataType = "TIMESTAMPTZ"
+	DT_TSVECTOR    DataType = "TSVECTOR"
+	DT_ARRAY       DataType = "[]"
 )
 
 const (
@@ -61,6 +79,7 @@ const (
 	CREATED_AT = "created_at"
 	UPDATED_AT = "updated_at"
 	DELETED_AT = "deleted_at"
+	VERSION    = "version"
 )
 
 const (
@@ -84,6 +103,12 @@ const (
 	NOT_BETWEEN           Operator = "NOT BETWEEN"
 	IS_NULL               Operator = "IS NULL"
 	IS_NOT_NULL           Operator = "IS NOT NULL"
+	EXISTS                Operator = "EXISTS"
+	NOT_EXISTS            Operator = "NOT EXISTS"
+	ARRAY_CONTAINS        Operator = "@>"
+	ARRAY_CONTAINED       Operator = "<@"
+	ARRAY_OVERLAPS        Operator = "&&"
+	ANY                   Operator = "= ANY"
 )
 
 const (
@@ -91,6 +116,35 @@ const (
 	DESC OrderDirection = "DESC"
 )
 
+const (
+	NULLS_FIRST NullsOrder = "NULLS FIRST"
+	NULLS_LAST  NullsOrder = "NULLS LAST"
+)
+
+const (
+	UNION     SetOperator = "UNION"
+	UNION_ALL SetOperator = "UNION ALL"
+	INTERSECT SetOperator = "INTERSECT"
+	EXCEPT    SetOperator = "EXCEPT"
+)
+
+const (
+	SEARCH_PLAIN  SearchMode = "plainto_tsquery"
+	SEARCH_PHRASE SearchMode = "phraseto_tsquery"
+	SEARCH_WEB    SearchMode = "websearch_to_tsquery"
+	SEARCH_RAW    SearchMode = "to_tsquery"
+)
+
+const (
+	LOCK_FOR_UPDATE LockMode = "FOR UPDATE"
+	LOCK_FOR_SHARE  LockMode = "FOR SHARE"
+)
+
+const (
+	LOCK_SKIP_LOCKED LockWait = "SKIP LOCKED"
+	LOCK_NOWAIT      LockWait = "NOWAIT"
+)
+
 const (
 	COUNT AggregateFunction = "COUNT"
 	MIN   AggregateFunction = "MIN"
@@ -98,3 +152,33 @@ const (
 	AVG   AggregateFunction = "AVG"
 	SUM   AggregateFunction = "SUM"
 )
+
+// UuidVersion is a replica of string type that used for specify the version of generated UUID primary keys
+type UuidVersion string
+
+const (
+	UUID_V4 UuidVersion = "v4"
+	UUID_V7 UuidVersion = "v7"
+)
+
+// WindowFunc is a replica of string type that used for store Window Function
+type WindowFunc string
+
+const (
+	ROW_NUMBER WindowFunc = "ROW_NUMBER"
+	RANK       WindowFunc = "RANK"
+	DENSE_RANK WindowFunc = "DENSE_RANK"
+	LAG        WindowFunc = "LAG"
+	LEAD       WindowFunc = "LEAD"
+)
+
+// StatementKind is a replica of string type that used for specify the kind of executed statement
+type StatementKind string
+
+const (
+	STMT_SELECT StatementKind = "SELECT"
+	STMT_INSERT StatementKind = "INSERT"
+	STMT_UPDATE StatementKind = "UPDATE"
+	STMT_DELETE StatementKind = "DELETE"
+	STMT_RAW    StatementKind = "RAW"
+)
@@ -0,0 +1,127 @@
+package goloquent
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCursor is returned when a cursor cannot be decoded or does not match the query key set
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrCursorRawOrder is returned when cursor pagination is ordered by a raw expression, whose value cannot be stored in the cursor
+var ErrCursorRawOrder = errors.New("cursor pagination cannot order by a raw expression, use OrderBy with columns instead")
+
+// CursorPaginator is a struct that is used to store the result of cursor pagination
+type CursorPaginator struct {
+	Data       interface{} `json:"data"`
+	NextCursor string      `json:"next_cursor"`
+	PrevCursor string      `json:"prev_cursor"`
+}
+
+// Cursor is a struct that is used to store the position of a row within an ordered key set
+type Cursor struct {
+	Backward bool          `json:"b,omitempty"`
+	Keys     []interface{} `json:"k"`
+}
+
+// keySet is a struct that is used to store a single column of the cursor key set
+type keySet struct {
+	column    string
+	direction OrderDirection
+}
+
+// Encode is a function that will encode the cursor into an opaque string
+func (c *Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor is a function that will decode an opaque string into Cursor
+func DecodeCursor(value string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+
+	if nil != err {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := &Cursor{}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(cursor); nil != err {
+		return nil, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+// buildKeySet is a function that will flatten the orders into key set, primary key is appended as tie breaker to keep the key set unique
+func buildKeySet(orders []*Order, pks ...string) []keySet {
+	var keys []keySet
+
+	direction := ASC
+	ordered := map[string]bool{}
+
+	for _, order := range orders {
+		for _, col := range order.Columns {
+			keys = append(keys, keySet{column: col, direction: order.Direction})
+
+			direction = order.Direction
+			ordered[col] = true
+		}
+	}
+
+	for _, pk := range pks {
+		if !ordered[pk] {
+			keys = append(keys, keySet{column: pk, direction: direction})
+		}
+	}
+
+	return keys
+}
+
+// buildKeySetCondition is a function that will generate the keyset predicate for rows after the given cursor
+func buildKeySetCondition(table string, keys []keySet, cursor *Cursor) *Condition {
+	var predicates []string
+
+	args := map[string]interface{}{}
+
+	for i, key := range keys {
+		var parts []string
+
+		for j := 0; j < i; j++ {
+			parts = append(parts, fmt.Sprintf(`"%s"."%s" = :cursor_%d`, table, keys[j].column, j))
+		}
+
+		op := GREATER_THAN
+		if DESC == key.direction {
+			op = LESS_THAN
+		}
+
+		parts = append(parts, fmt.Sprintf(`"%s"."%s" %s :cursor_%d`, table, key.column, op, i))
+		predicates = append(predicates, fmt.Sprintf("(%s)", strings.Join(parts, " AND ")))
+
+		args[fmt.Sprintf("cursor_%d", i)] = cursor.Keys[i]
+	}
+
+	return newRawCondition(AND, strings.Join(predicates, " OR "), args)
+}
+
+// cursorOf is a function that will take the key set values of the model as Cursor
+func cursorOf(model IModel, keys []keySet, backward bool) *Cursor {
+	payload := model.MapToPayload(model)
+
+	cursor := &Cursor{Backward: backward}
+
+	for _, key := range keys {
+		cursor.Keys = append(cursor.Keys, payload[key.column])
+	}
+
+	return cursor
+}
@@ -0,0 +1,95 @@
+package goloquent
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	cursor := &Cursor{Backward: true, Keys: []interface{}{"Action", 12}}
+
+	t.Run("TestCursor_ROUND_TRIP", func(t *testing.T) {
+		decoded, err := DecodeCursor(cursor.Encode())
+
+		require.NoError(t, err)
+		require.True(t, decoded.Backward)
+		require.Equal(t, []interface{}{"Action", json.Number("12")}, decoded.Keys)
+	})
+
+	t.Run("TestCursor_INVALID", func(t *testing.T) {
+		_, err := DecodeCursor("not a cursor")
+
+		require.Equal(t, ErrInvalidCursor, err)
+	})
+}
+
+func TestCursor_KeySet(t *testing.T) {
+	keys := buildKeySet([]*Order{newOrder(ASC, "name"), newOrder(DESC, "year")}, "id")
+
+	t.Run("TestCursor_KEY_SET", func(t *testing.T) {
+		require.Equal(t, []keySet{
+			{column: "name", direction: ASC},
+			{column: "year", direction: DESC},
+			{column: "id", direction: DESC},
+		}, keys)
+	})
+
+	t.Run("TestCursor_KEY_SET_CONDITION", func(t *testing.T) {
+		cond := buildKeySetCondition("movies", keys, &Cursor{Keys: []interface{}{"Alien", 1979, 7}})
+
+		require.True(t, cond.IsRaw)
+		require.Equal(t, `("movies"."name" > :cursor_0) OR ("movies"."name" = :cursor_0 AND "movies"."year" < :cursor_1) OR ("movies"."name" = :cursor_0 AND "movies"."year" = :cursor_1 AND "movies"."id" < :cursor_2)`, cond.Raw)
+		require.Equal(t, map[string]interface{}{"cursor_0": "Alien", "cursor_1": 1979, "cursor_2": 7}, cond.Value)
+	})
+}
+
+func TestCursor_Paginate(t *testing.T) {
+	t.Run("TestCursor_GROUPED_CONDITIONS", func(t *testing.T) {
+		db, fake := newFakeDB(t, genreRows([]int64{3, 4}, 2))
+
+		cursor := (&Cursor{Keys: []interface{}{2}}).Encode()
+
+		_, err := DB(db).Use(genreModel()).
+			Where("name", EQUAL, "a").
+			OrWhere("name", EQUAL, "b").
+			CursorPaginate(cursor, 1)
+
+		require.NoError(t, err)
+		require.Equal(t, `SELECT "genres"."id", "genres"."name" FROM "genres" WHERE ("genres"."name" = $1 OR "genres"."name" = $2) AND (("genres"."id" > $3)) ORDER BY "genres"."id" ASC LIMIT 2 `, fake.Statements[0])
+		require.Equal(t, []driver.Value{"a", "b", "2"}, fake.Args[0])
+	})
+
+	t.Run("TestCursor_FROM_SUB", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		cursor := (&Cursor{Keys: []interface{}{2}}).Encode()
+
+		sub := DB(db).Use(genreModel()).Where("name", EQUAL, "a")
+
+		_, err := DB(db).Use(genreModel()).FromSub(sub, "g").CursorPaginate(cursor, 1)
+
+		require.NoError(t, err)
+		require.Contains(t, fake.Statements[0], `WHERE (("g"."id" > $2)) ORDER BY "g"."id" ASC`)
+	})
+
+	t.Run("TestCursor_INVALID_LIMIT", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		_, err := DB(db).Use(genreModel()).CursorPaginate("", 0)
+
+		require.Error(t, err)
+		require.Empty(t, fake.Statements)
+	})
+
+	t.Run("TestCursor_RAW_ORDER", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		_, err := DB(db).Use(genreModel()).OrderByRaw("LENGTH(name)").CursorPaginate("", 10)
+
+		require.Equal(t, ErrCursorRawOrder, err)
+		require.Empty(t, fake.Statements)
+	})
+}
@@ -0,0 +1,135 @@
+package goloquent
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// fakeResponder returns the columns and rows of a query executed on the fake database
+type fakeResponder func(query string, args []driver.Value) ([]string, [][]driver.Value)
+
+// fakeDB records the statements executed through the fake driver
+type fakeDB struct {
+	mu         sync.Mutex
+	respond    fakeResponder
+	Statements []string
+	Args       [][]driver.Value
+}
+
+type fakeDriver struct{}
+type fakeConn struct{ db *fakeDB }
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+var (
+	fakeDBsMu sync.Mutex
+	fakeDBs   = map[string]*fakeDB{}
+)
+
+func init() {
+	sql.Register("goloquent_fake", fakeDriver{})
+}
+
+// newFakeDB is a function that will open a database whose queries are answered by respond
+func newFakeDB(t *testing.T, respond fakeResponder) (*sqlx.DB, *fakeDB) {
+	fake := &fakeDB{respond: respond}
+
+	fakeDBsMu.Lock()
+	fakeDBs[t.Name()] = fake
+	fakeDBsMu.Unlock()
+
+	db, err := sql.Open("goloquent_fake", t.Name())
+
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return sqlx.NewDb(db, "postgres"), fake
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeDBsMu.Lock()
+	defer fakeDBsMu.Unlock()
+
+	fake, ok := fakeDBs[name]
+
+	if !ok {
+		return nil, fmt.Errorf("unknown fake database %s", name)
+	}
+
+	return &fakeConn{db: fake}, nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (c *fakeConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c, nil
+}
+
+func (c *fakeConn) Commit() error { return nil }
+
+func (c *fakeConn) Rollback() error { return nil }
+
+func (s *fakeStmt) Close() error { return nil }
+
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) record(args []driver.Value) ([]string, [][]driver.Value) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	s.db.Statements = append(s.db.Statements, s.query)
+	s.db.Args = append(s.db.Args, args)
+
+	if nil == s.db.respond {
+		return nil, nil
+	}
+
+	return s.db.respond(s.query, args)
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	_, rows := s.record(args)
+
+	return driver.RowsAffected(len(rows)), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	columns, rows := s.record(args)
+
+	return &fakeRows{columns: columns, rows: rows}, nil
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package goloquent
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultSearchLanguage is the text search configuration used when no language is given
+const DefaultSearchLanguage = "english"
+
+// FullText is a struct that is used to store information about full text index
+type FullText struct {
+	columns  []string
+	language string
+	vector   bool
+}
+
+// FullTextOptions is a struct that is used to configure full text search
+type FullTextOptions struct {
+	// Language is the text search configuration, e.g. english or simple
+	Language string
+	// Mode is the function that parses the search term, plainto_tsquery is used by default
+	Mode SearchMode
+	// Vector marks the columns as TSVECTOR columns that are searched as is
+	Vector bool
+}
+
+func newFullText(columns ...string) *FullText {
+	return &FullText{
+		columns:  columns,
+		language: DefaultSearchLanguage,
+	}
+}
+
+// Language is a setter for text search configuration
+func (f *FullText) Language(language string) *FullText {
+	f.language = language
+
+	return f
+}
+
+// Vector is a setter for indexing TSVECTOR columns as is
+func (f *FullText) Vector() *FullText {
+	f.vector = true
+
+	return f
+}
+
+func (f *FullText) options() FullTextOptions {
+	return FullTextOptions{
+		Language: f.language,
+		Vector:   f.vector,
+	}
+}
+
+func fullTextOptionsOf(opts []FullTextOptions) FullTextOptions {
+	var options FullTextOptions
+
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
+	if "" == options.Language {
+		options.Language = DefaultSearchLanguage
+	}
+
+	if "" == options.Mode {
+		options.Mode = SEARCH_PLAIN
+	}
+
+	return options
+}
+
+// buildTsVector is a function that will generate the text search vector of the columns, columns are qualified when table is given
+func buildTsVector(table string, columns []string, options FullTextOptions) string {
+	var cols []string
+
+	for _, col := range columns {
+		if "" == table {
+			cols = append(cols, fmt.Sprintf(`"%s"`, col))
+		} else {
+			cols = append(cols, fmt.Sprintf(`"%s"."%s"`, table, col))
+		}
+	}
+
+	if options.Vector {
+		return strings.Join(cols, " || ")
+	}
+
+	if 1 == len(cols) {
+		return fmt.Sprintf("to_tsvector('%s', %s)", quoteLiteral(options.Language), cols[0])
+	}
+
+	for i, col := range cols {
+		cols[i] = fmt.Sprintf("coalesce(%s, '')", col)
+	}
+
+	return fmt.Sprintf("to_tsvector('%s', %s)", quoteLiteral(options.Language), strings.Join(cols, " || ' ' || "))
+}
+
+func buildTsQuery(options FullTextOptions) string {
+	return fmt.Sprintf("%s('%s', :term)", options.Mode, quoteLiteral(options.Language))
+}
+
+func quoteLiteral(value string) string {
+	return strings.Replace(value, "'", "''", -1)
+}
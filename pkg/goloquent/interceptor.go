@@ -0,0 +1,24 @@
+package goloquent
+
+import "context"
+
+// Handler is a function that executes a Statement
+type Handler func(ctx context.Context, stmt Statement) (Result, error)
+
+// Interceptor is a function that wraps the execution of a Statement.
+// An Interceptor may inspect or rewrite the Statement, short-circuit the execution or call next to continue the chain
+type Interceptor func(ctx context.Context, stmt Statement, next Handler) (Result, error)
+
+// chainInterceptors is a function that will wrap the handler with interceptors, the first interceptor will be the outermost
+func chainInterceptors(handler Handler, interceptors ...Interceptor) Handler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor := interceptors[i]
+		next := handler
+
+		handler = func(ctx context.Context, stmt Statement) (Result, error) {
+			return interceptor(ctx, stmt, next)
+		}
+	}
+
+	return handler
+}
@@ -0,0 +1,137 @@
+package goloquent
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type genre struct {
+	Model
+	ID   int64  `db:"id"`
+	Name string `db:"name"`
+}
+
+func genreModel() *genre {
+	return &genre{
+		Model: AutoIncrementModel("genres", "id", false, false),
+	}
+}
+
+func TestInterceptor_Chain(t *testing.T) {
+	var calls []string
+
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+			calls = append(calls, name)
+
+			return next(ctx, stmt)
+		}
+	}
+
+	handler := chainInterceptors(func(ctx context.Context, stmt Statement) (Result, error) {
+		calls = append(calls, stmt.Query)
+
+		return Result{}, nil
+	}, record("first"), record("second"))
+
+	t.Run("TestInterceptor_ORDER", func(t *testing.T) {
+		_, err := handler(context.Background(), newStatement(STMT_SELECT, "genres", "SELECT 1", nil))
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"first", "second", "SELECT 1"}, calls)
+	})
+}
+
+func TestInterceptor_Tracing(t *testing.T) {
+	exporter := NewInMemoryExporter()
+	blocked := errors.New("blocked")
+
+	query := DB(nil).Intercept(
+		Tracing(exporter),
+		func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+			return Result{}, blocked
+		},
+	)
+
+	model := genreModel()
+	model.ID = 1
+	model.Name = "Action"
+
+	t.Run("TestInterceptor_TRACING", func(t *testing.T) {
+		_, err := query.Use(model).Update()
+
+		require.Equal(t, blocked, err)
+
+		spans := exporter.Spans()
+
+		require.Len(t, spans, 1)
+		require.Equal(t, "UPDATE genres", spans[0].Name)
+		require.Equal(t, STMT_UPDATE, spans[0].Statement.Kind)
+		require.Equal(t, `UPDATE genres SET "name"=:name WHERE "id"=:id;`, spans[0].Statement.Query)
+		require.Equal(t, blocked, spans[0].Err)
+		require.False(t, spans[0].EndedAt.Before(spans[0].StartedAt))
+	})
+}
+
+func TestInterceptor_RowsAffected(t *testing.T) {
+	query := DB(nil).Intercept(func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+		return Result{Result: driver.RowsAffected(0)}, nil
+	})
+
+	model := genreModel()
+	model.ID = 1
+
+	t.Run("TestInterceptor_DELETE", func(t *testing.T) {
+		result, err := query.Use(model).Delete()
+
+		require.NoError(t, err)
+		require.Equal(t, int64(0), result.RowsAffected)
+	})
+}
+
+func TestInterceptor_ShortCircuit(t *testing.T) {
+	query := DB(nil).Intercept(func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+		return Result{}, nil
+	})
+
+	t.Run("TestInterceptor_GET", func(t *testing.T) {
+		result, err := query.Use(genreModel()).Get()
+
+		require.NoError(t, err)
+		require.Len(t, result, 0)
+	})
+
+	t.Run("TestInterceptor_FIRST", func(t *testing.T) {
+		_, err := query.Use(genreModel()).First()
+
+		require.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("TestInterceptor_INSERT", func(t *testing.T) {
+		_, err := query.Use(genreModel()).Insert()
+
+		require.NoError(t, err)
+	})
+
+	t.Run("TestInterceptor_EXISTS", func(t *testing.T) {
+		_, err := query.Use(genreModel()).Exists()
+
+		require.Equal(t, sql.ErrNoRows, err)
+	})
+
+	t.Run("TestInterceptor_PLUCK", func(t *testing.T) {
+		values, err := query.Use(genreModel()).Pluck("name")
+
+		require.NoError(t, err)
+		require.Empty(t, values)
+	})
+
+	t.Run("TestInterceptor_RAW", func(t *testing.T) {
+		require.NoError(t, query.RawQuery(genreModel(), "SELECT 1"))
+	})
+}
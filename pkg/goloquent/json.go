@@ -0,0 +1,146 @@
+package goloquent
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+var jsonPathSeparator = regexp.MustCompile(`->>|->`)
+
+// JSON is a struct for mapping a JSON or JSONB column into model field.
+// Data is marshalled when written, when scanned it is unmarshalled into Data if it is a pointer, otherwise into a generic value
+type JSON struct {
+	Data interface{}
+	raw  []byte
+}
+
+// NewJSON is a factory method for creating JSON field
+func NewJSON(data interface{}) JSON {
+	return JSON{Data: data}
+}
+
+// Value implements driver.Valuer
+func (j JSON) Value() (driver.Value, error) {
+	if nil == j.Data {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(j.Data)
+
+	if nil != err {
+		return nil, err
+	}
+
+	return string(raw), nil
+}
+
+// Scan implements sql.Scanner
+func (j *JSON) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		j.raw = nil
+		return nil
+	case []byte:
+		j.raw = append([]byte{}, v...)
+	case string:
+		j.raw = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into JSON", src)
+	}
+
+	if nil == j.Data || reflect.Ptr != reflect.TypeOf(j.Data).Kind() {
+		j.Data = nil
+
+		return json.Unmarshal(j.raw, &j.Data)
+	}
+
+	return json.Unmarshal(j.raw, j.Data)
+}
+
+// Decode is a function that will unmarshal the scanned JSON into dest
+func (j JSON) Decode(dest interface{}) error {
+	if nil != j.raw {
+		return json.Unmarshal(j.raw, dest)
+	}
+
+	if nil == j.Data {
+		return errors.New("json is null")
+	}
+
+	raw, err := json.Marshal(j.Data)
+
+	if nil != err {
+		return err
+	}
+
+	return json.Unmarshal(raw, dest)
+}
+
+// MarshalJSON implements json.Marshaler
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if nil == j.Data && nil != j.raw {
+		return j.raw, nil
+	}
+
+	return json.Marshal(j.Data)
+}
+
+// JsonSet is a function that creates jsonb_set expression which replaces the value at the path, e.g. JsonSet("meta->settings->theme", "dark")
+func JsonSet(path string, value interface{}) (Expression, error) {
+	raw, err := json.Marshal(value)
+
+	if nil != err {
+		return Expression{}, err
+	}
+
+	segments := jsonPathSeparator.Split(path, -1)
+
+	return Raw(
+		fmt.Sprintf(`jsonb_set("%s", CAST(:path AS TEXT[]), CAST(:value AS JSONB))`, segments[0]),
+		map[string]interface{}{
+			"path":  pq.Array(segments[1:]),
+			"value": string(raw),
+		},
+	), nil
+}
+
+// buildJsonPath is a function that will generate the accessor of a json path, e.g. meta->settings->>theme
+func buildJsonPath(table string, path string) string {
+	segments := jsonPathSeparator.Split(path, -1)
+	operators := jsonPathSeparator.FindAllString(path, -1)
+
+	query := fmt.Sprintf(`"%s"."%s"`, table, segments[0])
+
+	for i, op := range operators {
+		segment := segments[i+1]
+
+		if isJsonIndex(segment) {
+			query = fmt.Sprintf("%s%s%s", query, op, segment)
+		} else {
+			query = fmt.Sprintf("%s%s'%s'", query, op, strings.Replace(segment, "'", "''", -1))
+		}
+	}
+
+	return query
+}
+
+func isJsonIndex(segment string) bool {
+	if "" == segment {
+		return false
+	}
+
+	for _, c := range segment {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,88 @@
+package goloquent
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+type settings struct {
+	Theme string `json:"theme"`
+}
+
+func TestJSON_ValueAndScan(t *testing.T) {
+	t.Run("TestJSON_VALUE", func(t *testing.T) {
+		value, err := NewJSON(settings{Theme: "dark"}).Value()
+
+		require.NoError(t, err)
+		require.Equal(t, `{"theme":"dark"}`, value)
+	})
+
+	t.Run("TestJSON_SCAN_POINTER", func(t *testing.T) {
+		field := NewJSON(&settings{})
+
+		require.NoError(t, field.Scan([]byte(`{"theme":"light"}`)))
+		require.Equal(t, &settings{Theme: "light"}, field.Data)
+	})
+
+	t.Run("TestJSON_SCAN_VALUE", func(t *testing.T) {
+		field := NewJSON(settings{})
+		target := settings{}
+
+		require.NoError(t, field.Scan([]byte(`{"theme":"light"}`)))
+		require.Equal(t, map[string]interface{}{"theme": "light"}, field.Data)
+		require.NoError(t, field.Decode(&target))
+		require.Equal(t, "light", target.Theme)
+	})
+
+	t.Run("TestJSON_SCAN_DECODE", func(t *testing.T) {
+		field := JSON{}
+		target := settings{}
+
+		require.NoError(t, field.Scan(`{"theme":"light"}`))
+		require.Equal(t, map[string]interface{}{"theme": "light"}, field.Data)
+		require.NoError(t, field.Decode(&target))
+		require.Equal(t, "light", target.Theme)
+	})
+}
+
+func TestJSON_Path(t *testing.T) {
+	t.Run("TestJSON_PATH", func(t *testing.T) {
+		require.Equal(t, `"users"."meta"->'settings'->>'theme'`, buildJsonPath("users", "meta->settings->>theme"))
+		require.Equal(t, `"users"."tags"->0`, buildJsonPath("users", "tags->0"))
+	})
+
+	t.Run("TestJSON_SET", func(t *testing.T) {
+		expression, err := JsonSet("meta->settings->theme", "dark")
+
+		require.NoError(t, err)
+		require.Equal(t, `jsonb_set("meta", CAST(:path AS TEXT[]), CAST(:value AS JSONB))`, expression.SQL)
+		require.Equal(t, map[string]interface{}{
+			"path":  pq.Array([]string{"settings", "theme"}),
+			"value": `"dark"`,
+		}, expression.Args)
+	})
+}
+
+func TestJSON_Contains(t *testing.T) {
+	t.Run("TestJSON_CONTAINS", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		_, err := DB(db).Use(genreModel()).WhereJsonContains("name", []string{"a"}).Get()
+
+		require.NoError(t, err)
+		require.Equal(t, `SELECT "genres"."id", "genres"."name" FROM "genres" WHERE ("genres"."name" @> CAST($1 AS JSONB)) `, fake.Statements[0])
+		require.Equal(t, `["a"]`, fake.Args[0][0])
+	})
+
+	t.Run("TestJSON_CONTAINS_UNMARSHALLABLE", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		_, err := DB(db).Use(genreModel()).WhereJsonContains("name", make(chan int)).Get()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "json: unsupported type")
+		require.Empty(t, fake.Statements)
+	})
+}
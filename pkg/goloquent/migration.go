@@ -1,6 +1,7 @@
 package goloquent
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -11,8 +12,19 @@ type Migration struct {
 	Schema []*Schema
 }
 
-// Migrate is a function that is used to execute migration command
+// MigrationLockKey is the advisory lock key that guards Migrate from running concurrently
+var MigrationLockKey = AdvisoryKey("goloquent:migrate")
+
+// Migrate is a function that is used to execute migration command, only one process may migrate at a time
 func Migrate(db *sqlx.DB, database string, versions ...Migration) {
+	lock, err := AdvisoryLock(context.Background(), DB(db), MigrationLockKey)
+
+	if nil != err {
+		panic(err)
+	}
+
+	defer lock.Unlock(context.Background())
+
 	forceMigrate(db.MustBegin(), true)
 
 	runMeta(db)
@@ -1,6 +1,8 @@
 package goloquent
 
 import (
+	"database/sql/driver"
+	"fmt"
 	"reflect"
 	"time"
 )
@@ -10,12 +12,21 @@ type IModel interface {
 	GetModel() Model
 	GetTableName() string
 	GetPK() string
+	GetPrimaryKeys() []string
 	GetColumns(v IModel) []string
 	IsAutoIncrement() bool
 	IsUuid() bool
 	IsTimestamp() bool
 	IsSoftDelete() bool
+	IsVersioned() bool
+	GetVersionColumn() string
+	GetVersion(v IModel) int64
+	SetVersion(v IModel, version int64) error
 	MapToPayload(v IModel) map[string]interface{}
+	SyncOriginal(v IModel)
+	GetOriginal() map[string]interface{}
+	GetDirty(v IModel) map[string]interface{}
+	IsDirty(v IModel, columns ...string) bool
 
 	SetCreated()
 	SetUpdated()
@@ -26,13 +37,18 @@ type IModel interface {
 type Model struct {
 	Table         string
 	PrimaryKey    string
+	PrimaryKeys   []string
 	AutoIncrement bool
 	Uuid          bool
+	UuidVersion   UuidVersion
 	Timestamp     bool
 	SoftDelete    bool
+	Versioned     bool
+	VersionColumn string
 	CreatedAt     *time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt     *time.Time `db:"updated_at" json:"updated_at"`
 	DeletedAt     *time.Time `db:"deleted_at" json:"deleted_at"`
+	original      map[string]interface{}
 }
 
 // AutoIncrementModel is a factory method for creating Model with AutoIncrement TRUE
@@ -47,6 +63,32 @@ func AutoIncrementModel(table string, pk string, isTimestamp bool, isSoftDelete
 	}
 }
 
+// UuidModel is a factory method for creating Model with a UUID primary key generated on insert
+func UuidModel(table string, pk string, isTimestamp bool, isSoftDelete bool) Model {
+	return Model{
+		Table:         table,
+		PrimaryKey:    pk,
+		AutoIncrement: false,
+		Uuid:          true,
+		UuidVersion:   UUID_V4,
+		Timestamp:     isTimestamp,
+		SoftDelete:    isSoftDelete,
+	}
+}
+
+// CompositeKeyModel is a factory method for creating Model with a primary key made of several columns
+func CompositeKeyModel(table string, pks []string, isTimestamp bool, isSoftDelete bool) Model {
+	return Model{
+		Table:         table,
+		PrimaryKey:    pks[0],
+		PrimaryKeys:   pks,
+		AutoIncrement: false,
+		Uuid:          false,
+		Timestamp:     isTimestamp,
+		SoftDelete:    isSoftDelete,
+	}
+}
+
 // GetModel .
 func (m *Model) GetModel() Model {
 	return *m
@@ -62,6 +104,15 @@ func (m *Model) GetPK() string {
 	return m.PrimaryKey
 }
 
+// GetPrimaryKeys returns every column of the primary key
+func (m *Model) GetPrimaryKeys() []string {
+	if len(m.PrimaryKeys) > 0 {
+		return m.PrimaryKeys
+	}
+
+	return []string{m.PrimaryKey}
+}
+
 // GetColumns .
 func (m *Model) GetColumns(v IModel) []string {
 	var columns []string
@@ -101,6 +152,49 @@ func (m *Model) IsSoftDelete() bool {
 	return m.SoftDelete
 }
 
+// IsVersioned .
+func (m *Model) IsVersioned() bool {
+	return m.Versioned
+}
+
+// GetVersionColumn returns the column of the model field used by optimistic locking, "version" unless configured
+func (m *Model) GetVersionColumn() string {
+	if "" == m.VersionColumn {
+		return VERSION
+	}
+
+	return m.VersionColumn
+}
+
+// GetVersion returns the value of the version column field of the model, zero when the model has no such field
+func (m *Model) GetVersion(v IModel) int64 {
+	field, ok := fieldOf(v, m.GetVersionColumn())
+
+	if !ok {
+		return 0
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(field.Uint())
+	}
+
+	return 0
+}
+
+// SetVersion assigns the version column field of the model
+func (m *Model) SetVersion(v IModel, version int64) error {
+	field, ok := fieldOf(v, m.GetVersionColumn())
+
+	if !ok {
+		return fmt.Errorf("model %s does not have a %s column", m.Table, m.GetVersionColumn())
+	}
+
+	return assignField(field, version)
+}
+
 // MapToPayload .
 func (m *Model) MapToPayload(v IModel) map[string]interface{} {
 	var payload = make(map[string]interface{})
@@ -137,6 +231,57 @@ func (m *Model) MapToPayload(v IModel) map[string]interface{} {
 	return payload
 }
 
+// SyncOriginal remembers a snapshot of the current values of the model as its original values
+func (m *Model) SyncOriginal(v IModel) {
+	m.original = snapshotPayload(v.MapToPayload(v))
+}
+
+// GetOriginal returns the values of the model as they were loaded from the database, nil when the model was not loaded.
+// Values implementing driver.Valuer are kept as their driver value and pointers are dereferenced
+func (m *Model) GetOriginal() map[string]interface{} {
+	if nil == m.original {
+		return nil
+	}
+
+	original := make(map[string]interface{}, len(m.original))
+
+	for key, value := range m.original {
+		original[key] = value
+	}
+
+	return original
+}
+
+// GetDirty returns the values changed since the model was loaded, every value is dirty when the model was not loaded
+func (m *Model) GetDirty(v IModel) map[string]interface{} {
+	dirty := make(map[string]interface{})
+
+	for key, value := range v.MapToPayload(v) {
+		if original, ok := m.original[key]; !ok || !reflect.DeepEqual(original, snapshotOf(value)) {
+			dirty[key] = value
+		}
+	}
+
+	return dirty
+}
+
+// IsDirty checks whether any of the given columns changed since the model was loaded, every column is checked when none is given
+func (m *Model) IsDirty(v IModel, columns ...string) bool {
+	dirty := m.GetDirty(v)
+
+	if len(columns) == 0 {
+		return len(dirty) > 0
+	}
+
+	for _, col := range columns {
+		if _, ok := dirty[col]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // SetCreated .
 func (m *Model) SetCreated() {
 	if m.IsTimestamp() {
@@ -163,3 +308,162 @@ func (m *Model) SetDeleted() {
 		m.DeletedAt = &now
 	}
 }
+
+// fillModel is a function that will assign the values to the fields of the model tagged with the matching db column
+func fillModel(model IModel, values map[string]interface{}) error {
+	value := reflect.ValueOf(model).Elem()
+	typeOf := value.Type()
+
+	filled := 0
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		column := typeOf.Field(i).Tag.Get("db")
+		val, ok := values[column]
+
+		if "Model" == typeOf.Field(i).Name || !ok {
+			continue
+		}
+
+		if err := assignField(value.Field(i), val); nil != err {
+			return fmt.Errorf("column %s: %v", column, err)
+		}
+
+		filled++
+	}
+
+	if filled != len(values) {
+		return fmt.Errorf("model %s does not have every given column", model.GetTableName())
+	}
+
+	return nil
+}
+
+func assignField(field reflect.Value, val interface{}) error {
+	if nil == val {
+		field.Set(reflect.Zero(field.Type()))
+
+		return nil
+	}
+
+	v := reflect.ValueOf(val)
+
+	if reflect.Ptr == field.Kind() && reflect.Ptr != v.Kind() {
+		ptr := reflect.New(field.Type().Elem())
+
+		if err := assignField(ptr.Elem(), val); nil != err {
+			return err
+		}
+
+		field.Set(ptr)
+
+		return nil
+	}
+
+	switch {
+	case v.Type().AssignableTo(field.Type()):
+		field.Set(v)
+	case reflect.String == field.Kind() && reflect.String != v.Kind():
+		return fmt.Errorf("cannot assign %T to %s", val, field.Type())
+	case v.Type().ConvertibleTo(field.Type()):
+		field.Set(v.Convert(field.Type()))
+	default:
+		return fmt.Errorf("cannot assign %T to %s", val, field.Type())
+	}
+
+	return nil
+}
+
+// fieldOf is a function that will find the field of the model mapped to the given column
+func fieldOf(model IModel, column string) (reflect.Value, bool) {
+	value := reflect.ValueOf(model).Elem()
+	typeOf := value.Type()
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		if "Model" != typeOf.Field(i).Name && column == typeOf.Field(i).Tag.Get("db") {
+			return value.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// fillUuid is a function that will generate the UUID primary key of the model when it is empty
+func fillUuid(model IModel) error {
+	if !model.IsUuid() {
+		return nil
+	}
+
+	value := reflect.ValueOf(model).Elem()
+	typeOf := value.Type()
+
+	for i := 0; i < typeOf.NumField(); i++ {
+		if model.GetPK() != typeOf.Field(i).Tag.Get("db") {
+			continue
+		}
+
+		field := value.Field(i)
+
+		if !reflect.DeepEqual(field.Interface(), reflect.Zero(field.Type()).Interface()) {
+			return nil
+		}
+
+		uuid, err := NewUUID(model.GetModel().UuidVersion)
+
+		if nil != err {
+			return err
+		}
+
+		if reflect.Array == field.Kind() && field.Type().Elem().Kind() == reflect.Uint8 && field.Len() == len(uuid) {
+			reflect.Copy(field, reflect.ValueOf(uuid[:]))
+
+			return nil
+		}
+
+		return assignField(field, uuid.String())
+	}
+
+	return nil
+}
+
+// snapshotPayload is a function that will snapshot every value of the payload
+func snapshotPayload(payload map[string]interface{}) map[string]interface{} {
+	snapshot := make(map[string]interface{}, len(payload))
+
+	for key, value := range payload {
+		snapshot[key] = snapshotOf(value)
+	}
+
+	return snapshot
+}
+
+// snapshotOf is a function that will copy a column value so that in place modifications of slices, pointers
+// and driver.Valuer fields such as arrays and JSON are detected, valuers are kept as their driver value
+func snapshotOf(value interface{}) interface{} {
+	rv := reflect.ValueOf(value)
+
+	if reflect.Ptr == rv.Kind() && rv.IsNil() {
+		return nil
+	}
+
+	if valuer, ok := value.(driver.Valuer); ok {
+		if driverValue, err := valuer.Value(); nil == err {
+			return snapshotOf(driverValue)
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		return snapshotOf(rv.Elem().Interface())
+	case reflect.Slice:
+		if rv.IsNil() {
+			return value
+		}
+
+		copied := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(copied, rv)
+
+		return copied.Interface()
+	}
+
+	return value
+}
@@ -0,0 +1,117 @@
+package goloquent
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+type profile struct {
+	Model
+	ID       int64          `db:"id"`
+	Nickname *string        `db:"nickname"`
+	Tags     pq.StringArray `db:"tags"`
+	Settings JSON           `db:"settings"`
+}
+
+func profileModel() *profile {
+	nickname := "neo"
+
+	model := &profile{
+		Model:    AutoIncrementModel("profiles", "id", false, false),
+		ID:       1,
+		Nickname: &nickname,
+		Tags:     pq.StringArray{"admin", "staff"},
+		Settings: NewJSON(&settings{Theme: "dark"}),
+	}
+
+	model.SyncOriginal(model)
+
+	return model
+}
+
+func TestModel_Fill(t *testing.T) {
+	t.Run("ConvertibleValues", func(t *testing.T) {
+		model := movieModel()
+
+		err := fillModel(model, map[string]interface{}{"title": "Heat", "rating": 9})
+
+		require.NoError(t, err)
+		require.Equal(t, "Heat", model.Title)
+		require.Equal(t, int64(9), model.Rating)
+	})
+
+	t.Run("UnknownColumn", func(t *testing.T) {
+		require.Error(t, fillModel(movieModel(), map[string]interface{}{"budget": 1}))
+	})
+
+	t.Run("NumberIntoString", func(t *testing.T) {
+		require.Error(t, fillModel(movieModel(), map[string]interface{}{"title": 65}))
+	})
+}
+
+func TestModel_Dirty(t *testing.T) {
+	t.Run("NotLoaded", func(t *testing.T) {
+		model := movieModel()
+
+		require.Nil(t, model.GetOriginal())
+		require.True(t, model.IsDirty(model, "title"))
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		model := movieModel()
+		model.ID = 1
+		model.Title = "Heat"
+		model.SyncOriginal(model)
+
+		require.False(t, model.IsDirty(model))
+
+		model.Title = "Ronin"
+
+		require.True(t, model.IsDirty(model, "title"))
+		require.False(t, model.IsDirty(model, "rating"))
+		require.Equal(t, map[string]interface{}{"title": "Ronin"}, model.GetDirty(model))
+		require.Equal(t, "Heat", model.GetOriginal()["title"])
+	})
+
+	t.Run("UpdateWithoutChanges", func(t *testing.T) {
+		model := movieModel()
+		model.SyncOriginal(model)
+
+		result, err := DB(nil).Use(model).Update()
+
+		require.NoError(t, err)
+		require.Equal(t, int64(0), result.RowsAffected)
+	})
+}
+
+func TestModel_DirtyInPlace(t *testing.T) {
+	t.Run("Array", func(t *testing.T) {
+		model := profileModel()
+		model.Tags[0] = "guest"
+
+		require.Equal(t, []string{"tags"}, sortedKeys(model.GetDirty(model)))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		model := profileModel()
+		model.Settings.Data.(*settings).Theme = "light"
+
+		require.Equal(t, []string{"settings"}, sortedKeys(model.GetDirty(model)))
+	})
+
+	t.Run("Pointer", func(t *testing.T) {
+		model := profileModel()
+		*model.Nickname = "trinity"
+
+		require.Equal(t, []string{"nickname"}, sortedKeys(model.GetDirty(model)))
+		require.Equal(t, "neo", model.GetOriginal()["nickname"])
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		model := profileModel()
+
+		require.False(t, model.IsDirty(model))
+	})
+}
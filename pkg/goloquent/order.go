@@ -4,4 +4,30 @@ package goloquent
 type Order struct {
 	Columns   []string
 	Direction OrderDirection
+	Nulls     NullsOrder
+	Raw       string
+	Args      map[string]interface{}
+}
+
+func newOrder(direction OrderDirection, columns ...string) *Order {
+	return &Order{
+		Columns:   columns,
+		Direction: direction,
+	}
+}
+
+func newRawOrder(expression string, args map[string]interface{}) *Order {
+	return &Order{
+		Raw:  expression,
+		Args: args,
+	}
+}
+
+// reverse is a function that returns the opposite direction
+func (d OrderDirection) reverse() OrderDirection {
+	if DESC == d {
+		return ASC
+	}
+
+	return DESC
 }
@@ -0,0 +1,84 @@
+package goloquent
+
+import "reflect"
+
+// Paginator is a struct that is used to store the result of offset pagination
+type Paginator struct {
+	Data        interface{} `json:"data"`
+	Total       int64       `json:"total"`
+	PerPage     int         `json:"per_page"`
+	CurrentPage int         `json:"current_page"`
+	LastPage    int         `json:"last_page"`
+	From        int         `json:"from"`
+	To          int         `json:"to"`
+}
+
+// SimplePaginator is a struct that is used to store the result of offset pagination without total count
+type SimplePaginator struct {
+	Data        interface{} `json:"data"`
+	PerPage     int         `json:"per_page"`
+	CurrentPage int         `json:"current_page"`
+	HasMore     bool        `json:"has_more"`
+	From        int         `json:"from"`
+	To          int         `json:"to"`
+}
+
+func newPaginator(data interface{}, total int64, perPage int, currentPage int) *Paginator {
+	from, to := paginationRange(data, perPage, currentPage)
+
+	lastPage := int((total + int64(perPage) - 1) / int64(perPage))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	return &Paginator{
+		Data:        data,
+		Total:       total,
+		PerPage:     perPage,
+		CurrentPage: currentPage,
+		LastPage:    lastPage,
+		From:        from,
+		To:          to,
+	}
+}
+
+func newSimplePaginator(data interface{}, hasMore bool, perPage int, currentPage int) *SimplePaginator {
+	from, to := paginationRange(data, perPage, currentPage)
+
+	return &SimplePaginator{
+		Data:        data,
+		PerPage:     perPage,
+		CurrentPage: currentPage,
+		HasMore:     hasMore,
+		From:        from,
+		To:          to,
+	}
+}
+
+// paginationRange is a function that returns the 1-based position of the first and last item of the page
+func paginationRange(data interface{}, perPage int, currentPage int) (int, int) {
+	length := reflect.ValueOf(data).Len()
+
+	if 0 == length {
+		return 0, 0
+	}
+
+	offset := (currentPage - 1) * perPage
+
+	return offset + 1, offset + length
+}
+
+// pageOf is a function that returns the current page and page size of the pagination arguments
+func pageOf(page int, limit []int) (int, int) {
+	perPage := 50
+
+	if len(limit) > 0 && limit[0] > 0 {
+		perPage = limit[0]
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	return page, perPage
+}
@@ -0,0 +1,124 @@
+package goloquent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginator_Metadata(t *testing.T) {
+	t.Run("TestPaginator_MIDDLE_PAGE", func(t *testing.T) {
+		paginator := newPaginator([]*genre{{}, {}, {}}, 23, 3, 2)
+
+		require.Equal(t, int64(23), paginator.Total)
+		require.Equal(t, 3, paginator.PerPage)
+		require.Equal(t, 2, paginator.CurrentPage)
+		require.Equal(t, 8, paginator.LastPage)
+		require.Equal(t, 4, paginator.From)
+		require.Equal(t, 6, paginator.To)
+	})
+
+	t.Run("TestPaginator_EMPTY", func(t *testing.T) {
+		paginator := newPaginator([]*genre{}, 0, 10, 1)
+
+		require.Equal(t, 1, paginator.LastPage)
+		require.Equal(t, 0, paginator.From)
+		require.Equal(t, 0, paginator.To)
+	})
+}
+
+func TestPaginator_Simple(t *testing.T) {
+	paginator := newSimplePaginator([]*genre{{}, {}}, false, 10, 3)
+
+	t.Run("TestPaginator_SIMPLE", func(t *testing.T) {
+		require.False(t, paginator.HasMore)
+		require.Equal(t, 21, paginator.From)
+		require.Equal(t, 22, paginator.To)
+	})
+}
+
+func TestPaginator_PageOf(t *testing.T) {
+	t.Run("TestPaginator_DEFAULT_LIMIT", func(t *testing.T) {
+		page, perPage := pageOf(0, nil)
+
+		require.Equal(t, 1, page)
+		require.Equal(t, 50, perPage)
+	})
+
+	t.Run("TestPaginator_LIMIT", func(t *testing.T) {
+		page, perPage := pageOf(4, []int{15})
+
+		require.Equal(t, 4, page)
+		require.Equal(t, 15, perPage)
+	})
+}
+
+// countRows is a function that answers count queries with total and any other query with no rows
+func countRows(total int64) fakeResponder {
+	return func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		if strings.Contains(query, "COUNT(") {
+			return []string{"count"}, [][]driver.Value{{total}}
+		}
+
+		return []string{"id", "name"}, nil
+	}
+}
+
+func TestPaginator_Paginate(t *testing.T) {
+	t.Run("TestPaginator_COUNT", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(7))
+
+		paginator, err := DB(db).Use(genreModel()).
+			Where("name", EQUAL, "a").
+			OrderBy(ASC, "name").
+			Paginate(2, 3)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(7), paginator.Total)
+		require.Equal(t, `SELECT COUNT("genres".*) FROM "genres" WHERE "genres"."name" = $1 `, fake.Statements[1])
+	})
+
+	t.Run("TestPaginator_COUNT_GROUP_BY", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(2))
+
+		paginator, err := DB(db).Use(genreModel()).
+			Select("name").
+			GroupBy("name").
+			Paginate(1, 10)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(2), paginator.Total)
+		require.Equal(t, `SELECT COUNT("aggregate".*) FROM (SELECT "genres"."name" FROM "genres" GROUP BY "genres"."name") AS "aggregate" `, fake.Statements[1])
+	})
+
+	t.Run("TestPaginator_COUNT_DISTINCT", func(t *testing.T) {
+		db, fake := newFakeDB(t, countRows(2))
+
+		_, err := DB(db).Use(genreModel()).Distinct().Where("name", EQUAL, "a").Paginate(1, 10)
+
+		require.NoError(t, err)
+		require.Equal(t, `SELECT COUNT("aggregate".*) FROM (SELECT DISTINCT "genres"."id", "genres"."name" FROM "genres" WHERE "genres"."name" = $1) AS "aggregate" `, fake.Statements[1])
+	})
+
+	t.Run("TestPaginator_COUNT_ERROR", func(t *testing.T) {
+		db, _ := newFakeDB(t, countRows(7))
+
+		failure := errors.New("count failed")
+
+		_, err := DB(db).Use(genreModel()).
+			Intercept(func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+				if strings.Contains(stmt.Query, "COUNT(") {
+					return Result{}, failure
+				}
+
+				return next(ctx, stmt)
+			}).
+			Paginate(1, 10)
+
+		require.Equal(t, failure, err)
+	})
+}
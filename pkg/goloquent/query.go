@@ -1,21 +1,40 @@
 package goloquent
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"reflect"
-	"time"
 
 	"github.com/jmoiron/sqlx"
 )
 
+// ErrLockWithoutTransaction is returned when a row locking query is executed outside of a transaction
+var ErrLockWithoutTransaction = errors.New("row locking requires an active transaction, call BeginTransaction before locking rows")
+
+// ErrLockUnsupported is returned when a row locking query aggregates, groups or combines rows, which can not be locked individually
+var ErrLockUnsupported = errors.New("row locking can not be combined with aggregate, group by, window, distinct or compound selects")
+
+// ErrCompositeKey is returned when a composite primary key is not given as a map of every key column
+var ErrCompositeKey = errors.New("composite primary key requires a map with a value for every key column")
+
+// ErrKeysNotSlice is returned when several primary keys are not given as a slice or an array
+var ErrKeysNotSlice = errors.New("primary keys must be given as a slice or an array")
+
+// ErrStaleObject is returned when a versioned model was modified by someone else since it was loaded
+var ErrStaleObject = errors.New("stale object, the row was modified since it was loaded")
+
 // Query .
 type Query struct {
-	Builder *Builder
-	DB      *sqlx.DB
-	Tx      *sqlx.Tx
-	Model   IModel
-	Binding Binding
+	Builder      *Builder
+	DB           *sqlx.DB
+	Tx           *sqlx.Tx
+	Model        IModel
+	Binding      Binding
+	ctx          context.Context
+	interceptors []Interceptor
+	batchSize    int
 }
 
 // DB .
@@ -33,6 +52,124 @@ func (q *Query) Use(model IModel) *Query {
 	return q
 }
 
+// WithContext method sets the context used for executing statements
+func (q *Query) WithContext(ctx context.Context) *Query {
+	q.ctx = ctx
+
+	return q
+}
+
+// Intercept method registers interceptors that wrap every executed statement, the first interceptor will be the outermost
+func (q *Query) Intercept(interceptors ...Interceptor) *Query {
+	q.interceptors = append(q.interceptors, interceptors...)
+
+	return q
+}
+
+// BatchSize method limits the number of rows inserted by each statement of BulkInsert, batches are always kept under MaxBindParameters
+func (q *Query) BatchSize(size int) *Query {
+	q.batchSize = size
+
+	return q
+}
+
+// Select method specifies the columns to be retrieved instead of the model columns
+func (q *Query) Select(columns ...string) *Query {
+	q.Binding.Columns = append(q.Binding.Columns, columns...)
+
+	return q
+}
+
+// SelectAggregates method adds aggregate columns to the query, combined with GroupBy it computes them per group
+func (q *Query) SelectAggregates(aggregates ...*Aggregate) *Query {
+	q.Binding.Aggregates = append(q.Binding.Aggregates, aggregates...)
+
+	return q
+}
+
+// SelectWindow method adds window function columns to the query
+func (q *Query) SelectWindow(functions ...*WindowFunction) *Query {
+	q.Binding.Windows = append(q.Binding.Windows, functions...)
+
+	return q
+}
+
+// Distinct method forces the query to return distinct rows, aggregates will only take distinct values into account
+func (q *Query) Distinct() *Query {
+	q.Binding.Distinct = true
+
+	return q
+}
+
+// DistinctOn method keeps only the first row of each set of rows where the given columns are equal
+func (q *Query) DistinctOn(columns ...string) *Query {
+	q.Binding.DistinctOn = append(q.Binding.DistinctOn, columns...)
+
+	return q
+}
+
+// SelectSub method adds the result of a subquery as a column with the given alias
+func (q *Query) SelectSub(sub *Query, alias string) *Query {
+	q.Binding.Selects = append(q.Binding.Selects, newSubQuery(sub, alias))
+
+	return q
+}
+
+// FromSub method uses the result of a subquery as the table of the query, columns are qualified with the given alias
+func (q *Query) FromSub(sub *Query, alias string) *Query {
+	q.Binding.From = newSubQuery(sub, alias)
+
+	return q
+}
+
+// From method selects from the given table or common table expression instead of the model table, columns are qualified with the given table
+func (q *Query) From(table string) *Query {
+	q.Binding.From = newSubQuery(nil, table)
+
+	return q
+}
+
+// WithCTE method adds a common table expression that prefixes the statement
+func (q *Query) WithCTE(name string, sub *Query) *Query {
+	q.Binding.CTEs = append(q.Binding.CTEs, newCTE(name, sub, nil))
+
+	return q
+}
+
+// WithRecursive method adds a recursive common table expression that prefixes the statement, the recursive term may refer to the expression name
+func (q *Query) WithRecursive(name string, anchor *Query, recursive *Query) *Query {
+	q.Binding.CTEs = append(q.Binding.CTEs, newCTE(name, anchor, recursive))
+
+	return q
+}
+
+// Union method combines the result of the query with the given query removing duplicate rows.
+// OrderBy, Take and Skip of the outer query apply to the combined result
+func (q *Query) Union(other *Query) *Query {
+	return q.compound(UNION, other)
+}
+
+// UnionAll method combines the result of the query with the given query keeping duplicate rows
+func (q *Query) UnionAll(other *Query) *Query {
+	return q.compound(UNION_ALL, other)
+}
+
+// Intersect method keeps only rows that are returned by both the query and the given query
+func (q *Query) Intersect(other *Query) *Query {
+	return q.compound(INTERSECT, other)
+}
+
+// ExceptQuery method removes rows that are returned by the given query from the result of the query
+func (q *Query) ExceptQuery(other *Query) *Query {
+	return q.compound(EXCEPT, other)
+}
+
+func (q *Query) compound(operator SetOperator, other *Query) *Query {
+	q.Binding.Compounds = append(q.Binding.Compounds, newCompound(operator, other))
+
+	return q
+}
+
 // GroupBy methods may be used to group the query results
 func (q *Query) GroupBy(columns ...string) *Query {
 	q.Binding.GroupBy = columns
@@ -40,6 +177,15 @@ func (q *Query) GroupBy(columns ...string) *Query {
 	return q
 }
 
+// HavingRaw method adds a raw condition on the grouped results, args may be positional for '?' placeholders or a single map for named parameters
+func (q *Query) HavingRaw(raw string, args ...interface{}) *Query {
+	raw, named := newRawArgs(raw, args)
+
+	q.Binding.Havings = append(q.Binding.Havings, newRawCondition(AND, raw, named))
+
+	return q
+}
+
 // Skip method used to skip a given number of results in the query
 func (q *Query) Skip(amount int) *Query {
 	q.Binding.Offset = amount
@@ -55,13 +201,95 @@ func (q *Query) Take(amount int) *Query {
 }
 
 // OrderBy method allows you to sort the result of the query by a given column.
-// The first argument to the orderBy method should be the column you wish to sort by, while the second argument controls the direction of the sort and may be either asc or desc
+// The first argument to the orderBy method controls the direction of the sort and may be either asc or desc, while the rest are the columns you wish to sort by.
+// OrderBy may be chained multiple times to sort by several columns with independent directions
 func (q *Query) OrderBy(direction OrderDirection, columns ...string) *Query {
-	q.Binding.Order = &Order{
-		Columns:   columns,
-		Direction: direction,
+	q.Binding.Orders = append(q.Binding.Orders, newOrder(direction, columns...))
+
+	return q
+}
+
+// OrderByRaw method allows you to sort the result of the query by a raw expression, args may be positional for '?' placeholders or a single map for named parameters
+func (q *Query) OrderByRaw(expression string, args ...interface{}) *Query {
+	expression, named := newRawArgs(expression, args)
+
+	q.Binding.Orders = append(q.Binding.Orders, newRawOrder(expression, named))
+
+	return q
+}
+
+// Latest method sorts the result of the query descending by the given column, created_at is used when no column is given
+func (q *Query) Latest(columns ...string) *Query {
+	if len(columns) < 1 {
+		columns = []string{CREATED_AT}
+	}
+
+	return q.OrderBy(DESC, columns...)
+}
+
+// Oldest method sorts the result of the query ascending by the given column, created_at is used when no column is given
+func (q *Query) Oldest(columns ...string) *Query {
+	if len(columns) < 1 {
+		columns = []string{CREATED_AT}
 	}
 
+	return q.OrderBy(ASC, columns...)
+}
+
+// InRandomOrder method sorts the result of the query randomly
+func (q *Query) InRandomOrder() *Query {
+	return q.OrderByRaw("RANDOM()")
+}
+
+// NullsFirst method places null values before non-null values on the last given order
+func (q *Query) NullsFirst() *Query {
+	return q.nulls(NULLS_FIRST)
+}
+
+// NullsLast method places null values after non-null values on the last given order
+func (q *Query) NullsLast() *Query {
+	return q.nulls(NULLS_LAST)
+}
+
+func (q *Query) nulls(position NullsOrder) *Query {
+	if len(q.Binding.Orders) > 0 {
+		q.Binding.Orders[len(q.Binding.Orders)-1].Nulls = position
+	}
+
+	return q
+}
+
+// LockForUpdate method locks the selected rows against concurrent updates until the transaction ends, it requires an active transaction
+func (q *Query) LockForUpdate() *Query {
+	q.Binding.Lock = LOCK_FOR_UPDATE
+
+	return q
+}
+
+// SharedLock method locks the selected rows against concurrent updates while allowing other shared locks, it requires an active transaction
+func (q *Query) SharedLock() *Query {
+	q.Binding.Lock = LOCK_FOR_SHARE
+
+	return q
+}
+
+// SkipLocked method skips rows that are locked by another transaction instead of waiting, FOR UPDATE is used when no lock is given
+func (q *Query) SkipLocked() *Query {
+	return q.lockWait(LOCK_SKIP_LOCKED)
+}
+
+// NoWait method fails immediately when a selected row is locked by another transaction, FOR UPDATE is used when no lock is given
+func (q *Query) NoWait() *Query {
+	return q.lockWait(LOCK_NOWAIT)
+}
+
+func (q *Query) lockWait(wait LockWait) *Query {
+	if "" == q.Binding.Lock {
+		q.Binding.Lock = LOCK_FOR_UPDATE
+	}
+
+	q.Binding.LockWait = wait
+
 	return q
 }
 
@@ -109,11 +337,9 @@ func (q *Query) bulkPayload(data []interface{}) map[string]interface{} {
 
 		for key, value := range payload {
 			payloads[fmt.Sprintf("%d%s", i, key)] = value
-
-			if model.IsTimestamp() {
-				payloads[fmt.Sprintf("%d%s", i, CREATED_AT)] = time.Now()
-			}
 		}
+
+		q.Builder.mergePayload(payloads, q.Builder.buildExpressionPayload(fmt.Sprintf("e_%d", i), payload))
 	}
 
 	return payloads
@@ -140,47 +366,178 @@ func (q *Query) makeTypeOf(sample interface{}) (interface{}, error) {
 }
 
 func (q *Query) mapToSliceModel(slice interface{}) interface{} {
-	return reflect.ValueOf(slice).Elem().Interface()
+	rows := reflect.ValueOf(slice).Elem()
+
+	for i := 0; i < rows.Len(); i++ {
+		q.loadModel(rows.Index(i).Interface())
+	}
+
+	return rows.Interface()
 }
 
+// assignModel is a function that will copy the model configuration into the result while keeping its own timestamps and original values
 func (q *Query) assignModel(result interface{}, m Model) interface{} {
-	value := reflect.ValueOf(result).Interface()
+	assignedModel := reflect.ValueOf(result)
+	field := assignedModel.Elem().FieldByName("Model")
+
+	scanned := field.Interface().(Model)
 
-	model := reflect.ValueOf(m)
+	m.CreatedAt, m.UpdatedAt, m.DeletedAt = scanned.CreatedAt, scanned.UpdatedAt, scanned.DeletedAt
+	m.original = scanned.original
 
-	assignedModel := reflect.ValueOf(value)
-	assignedModel.Elem().FieldByName("Model").Set(model)
+	field.Set(reflect.ValueOf(m))
 
 	return assignedModel.Interface()
 }
 
+// loadModel is a function that will assign the model configuration into a row read from the database and remember its original values
+func (q *Query) loadModel(result interface{}) interface{} {
+	model := q.assignModel(result, q.Model.GetModel()).(IModel)
+
+	model.SyncOriginal(model)
+
+	return model
+}
+
+// prepareInsert is a function that will fill the timestamps and generated values of the model before it is inserted
+func prepareInsert(model IModel) error {
+	model.SetCreated()
+	model.SetUpdated()
+
+	if model.IsVersioned() && 0 == model.GetVersion(model) {
+		if err := model.SetVersion(model, 1); nil != err {
+			return err
+		}
+	}
+
+	return fillUuid(model)
+}
+
+// wherePrimaryKey is a function that will constrain the query to the given primary key value
+func (q *Query) wherePrimaryKey(value interface{}) error {
+	pks := q.Model.GetPrimaryKeys()
+
+	if len(pks) == 1 {
+		q.Where(pks[0], EQUAL, value)
+
+		return nil
+	}
+
+	key, ok := value.(map[string]interface{})
+
+	if !ok || len(key) != len(pks) {
+		return ErrCompositeKey
+	}
+
+	for _, pk := range pks {
+		if _, ok := key[pk]; !ok {
+			return ErrCompositeKey
+		}
+
+		q.Where(pk, EQUAL, key[pk])
+	}
+
+	return nil
+}
+
 func (q *Query) resetBindings() {
 	q.Binding = Binding{}
 }
 
-func (q *Query) prepareNamed(query string) (*sqlx.NamedStmt, map[string]interface{}, error) {
-	stmt, err := q.DB.PrepareNamed(query)
+func (q *Query) context() context.Context {
+	if nil == q.ctx {
+		return context.Background()
+	}
 
-	return stmt, q.mapConditionPayload(), err
+	return q.ctx
 }
 
-func (q *Query) mapConditionPayload() map[string]interface{} {
-	payload := map[string]interface{}{}
-
-	for i, v := range q.Binding.Conditions {
-		switch v.Operator {
-		case IN, NOT_IN:
-			payload = q.Builder.buildInValue(payload, i, v)
-		case BETWEEN, NOT_BETWEEN:
-			payload = q.Builder.buildBetweenValue(payload, i, v)
-		default:
-			if !v.IsCompareColumn {
-				key := fmt.Sprintf("%d%s", i, v.Column)
-
-				payload[key] = v.Value
-			}
+func (q *Query) ext() sqlx.ExtContext {
+	if nil != q.Tx {
+		return q.Tx
+	}
+
+	return q.DB
+}
+
+func (q *Query) tableName() string {
+	if nil == q.Model {
+		return ""
+	}
+
+	return q.Model.GetTableName()
+}
+
+// execute is a function that will pass the statement through registered interceptors before running it
+func (q *Query) execute(kind StatementKind, query string, args interface{}) (Result, error) {
+	return q.executeOn(q.context(), q.run, kind, query, args)
+}
+
+// executeOn is a function that will pass the statement through registered interceptors before running it with the given handler
+func (q *Query) executeOn(ctx context.Context, run Handler, kind StatementKind, query string, args interface{}) (Result, error) {
+	if STMT_SELECT == kind && "" != q.Binding.Lock && nil == q.Tx {
+		return Result{}, ErrLockWithoutTransaction
+	}
+
+	if STMT_SELECT == kind && "" != q.Binding.Lock && !q.Binding.lockable() {
+		return Result{}, ErrLockUnsupported
+	}
+
+	if err := invalidArgumentOf(args); nil != err {
+		return Result{}, err
+	}
+
+	handler := chainInterceptors(run, q.interceptors...)
+
+	return handler(ctx, newStatement(kind, q.tableName(), query, args))
+}
+
+func (q *Query) run(ctx context.Context, stmt Statement) (Result, error) {
+	var result Result
+	var err error
+
+	if positional, ok := stmt.Args.([]interface{}); ok {
+		if stmt.IsQuery() {
+			result.Rows, err = q.ext().QueryxContext(ctx, stmt.Query, positional...)
+		} else {
+			result.Result, err = q.ext().ExecContext(ctx, stmt.Query, positional...)
+		}
+
+		return result, err
+	}
+
+	if stmt.IsQuery() {
+		result.Rows, err = sqlx.NamedQueryContext(ctx, q.ext(), stmt.Query, stmt.Args)
+	} else {
+		result.Result, err = sqlx.NamedExecContext(ctx, q.ext(), stmt.Query, stmt.Args)
+	}
+
+	return result, err
+}
+
+// scanOne is a function that will scan the first row into dest and close the rows
+func (q *Query) scanOne(rows *sqlx.Rows, dest interface{}) error {
+	if nil == rows {
+		return sql.ErrNoRows
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); nil != err {
+			return err
 		}
+
+		return sql.ErrNoRows
 	}
 
-	return payload
+	if _, ok := dest.(IModel); ok {
+		return rows.StructScan(dest)
+	}
+
+	return rows.Scan(dest)
+}
+
+func (q *Query) mapConditionPayload() map[string]interface{} {
+	return q.Builder.buildPayload(q.Binding)
 }
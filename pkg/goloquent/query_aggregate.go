@@ -1,12 +1,22 @@
 package goloquent
 
-// Count is an aggregate function for retrive row count
+import "github.com/jmoiron/sqlx"
+
+// Count is an aggregate function for retrive row count, distinct and grouped queries count their resulting rows
 func (q *Query) Count() int64 {
 	defer q.resetBindings()
 
+	total, _ := q.countOf(q.Binding)
+
+	return total
+}
+
+// CountDistinct is an aggregate function for retrive count of distinct column values
+func (q *Query) CountDistinct(column string) int64 {
 	defer q.resetBindings()
 
-	q.Binding.Aggregate = newAggregate(COUNT, "*")
+	q.Binding.Aggregate = newAggregate(COUNT, column)
+	q.Binding.Aggregate.Distinct = true
 
 	return int64(q.execAggregate())
 }
@@ -53,12 +63,99 @@ func (q *Query) Sum(column string) float64 {
 	return q.execAggregate()
 }
 
+// GetAggregates is a function that will return the rows of a SelectAggregates query as maps keyed by column,
+// integer results are kept as int64 while NUMERIC results are returned as string to preserve their precision
+func (q *Query) GetAggregates() ([]map[string]interface{}, error) {
+	defer q.resetBindings()
+
+	rows, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
+
+	if nil != err || nil == rows.Rows {
+		return nil, err
+	}
+
+	defer rows.Rows.Close()
+
+	var results []map[string]interface{}
+
+	for rows.Rows.Next() {
+		row := map[string]interface{}{}
+
+		if err := rows.Rows.MapScan(row); nil != err {
+			return nil, err
+		}
+
+		for column, value := range row {
+			row[column] = normalizeValue(value)
+		}
+
+		results = append(results, row)
+	}
+
+	return results, rows.Rows.Err()
+}
+
+// ScanAggregates is a function that will scan the rows of a SelectAggregates query into dest, a pointer to a struct slice
+func (q *Query) ScanAggregates(dest interface{}) error {
+	defer q.resetBindings()
+
+	rows, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
+
+	if nil != err || nil == rows.Rows {
+		return err
+	}
+
+	defer rows.Rows.Close()
+
+	return sqlx.StructScan(rows.Rows, dest)
+}
+
 func (q *Query) execAggregate() float64 {
+	result, _ := q.queryAggregate()
+
+	return result
+}
+
+// queryAggregate is a function that will execute the aggregate of the binding and return its error
+func (q *Query) queryAggregate() (float64, error) {
 	var result float64
 
-	stmt, args, _ := q.prepareNamed(q.ToSQL())
+	if q.Binding.Distinct {
+		q.Binding.Aggregate.Distinct = true
+	}
 
-	stmt.Get(&result, args)
+	rows, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
 
-	return result
+	if nil != err {
+		return result, err
+	}
+
+	err = q.scanOne(rows.Rows, &result)
+
+	return result, err
+}
+
+// countOf is a function that will count the rows of the binding regardless of its limit, offset and orders,
+// grouped, distinct and compound selects are counted as a subquery so every resulting row is counted once
+func (q *Query) countOf(binding Binding) (int64, error) {
+	binding.Limit = 0
+	binding.Offset = 0
+	binding.Orders = nil
+	binding.Lock = ""
+	binding.LockWait = ""
+
+	if len(binding.GroupBy) > 0 || binding.Distinct || len(binding.DistinctOn) > 0 || len(binding.Compounds) > 0 {
+		sub := &Query{Builder: q.Builder, Model: q.Model, Binding: binding}
+		sub.Binding.CTEs = nil
+
+		binding = Binding{CTEs: binding.CTEs, From: newSubQuery(sub, "aggregate")}
+	}
+
+	binding.Aggregate = newAggregate(COUNT, "*")
+
+	q.Binding = binding
+
+	total, err := q.queryAggregate()
+
+	return int64(total), err
 }
@@ -0,0 +1,57 @@
+package goloquent
+
+import "github.com/lib/pq"
+
+// WhereArrayContains method verifies that an array column contains every element of the given slice
+func (q *Query) WhereArrayContains(column string, values interface{}) *Query {
+	cond := newCondition(AND, column, ARRAY_CONTAINS, pq.Array(values))
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+// OrWhereArrayContains method verifies that an array column contains every element of the given slice
+func (q *Query) OrWhereArrayContains(column string, values interface{}) *Query {
+	cond := newCondition(OR, column, ARRAY_CONTAINS, pq.Array(values))
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+// WhereArrayOverlaps method verifies that an array column has at least one element in common with the given slice
+func (q *Query) WhereArrayOverlaps(column string, values interface{}) *Query {
+	cond := newCondition(AND, column, ARRAY_OVERLAPS, pq.Array(values))
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+// OrWhereArrayOverlaps method verifies that an array column has at least one element in common with the given slice
+func (q *Query) OrWhereArrayOverlaps(column string, values interface{}) *Query {
+	cond := newCondition(OR, column, ARRAY_OVERLAPS, pq.Array(values))
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+// WhereAny method verifies that a given column's value is contained within the given slice, the slice is bound as a single array parameter
+func (q *Query) WhereAny(column string, values interface{}) *Query {
+	cond := newCondition(AND, column, ANY, pq.Array(values))
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+// OrWhereAny method verifies that a given column's value is contained within the given slice, the slice is bound as a single array parameter
+func (q *Query) OrWhereAny(column string, values interface{}) *Query {
+	cond := newCondition(OR, column, ANY, pq.Array(values))
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
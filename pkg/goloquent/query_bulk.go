@@ -0,0 +1,185 @@
+package goloquent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/lib/pq"
+)
+
+// MaxBindParameters is the maximum number of bind parameters PostgreSQL accepts in a single statement
+const MaxBindParameters = 65535
+
+// BulkCopy is a function that inserts every model of the slice using COPY FROM STDIN within a transaction.
+// It is the fastest way to load many rows but raw expressions and returning columns are not supported
+func (q *Query) BulkCopy(data interface{}) (WriteResult, error) {
+	defer q.resetBindings()
+
+	models, err := q.bulkModels(data)
+
+	if nil != err {
+		return WriteResult{}, err
+	}
+
+	columns := q.Builder.buildInsertColumnList(q.Model)
+	query := pq.CopyIn(q.Model.GetTableName(), columns...)
+
+	var written WriteResult
+
+	err = q.transaction(func() error {
+		handler := chainInterceptors(func(ctx context.Context, stmt Statement) (Result, error) {
+			affected, err := q.copyIn(ctx, stmt.Query, columns, models)
+
+			return Result{Result: driver.RowsAffected(affected)}, err
+		}, q.interceptors...)
+
+		result, err := handler(q.context(), newStatement(STMT_INSERT, q.tableName(), query, nil))
+
+		if nil != err {
+			return err
+		}
+
+		written, err = writeResultOf(result)
+
+		return err
+	})
+
+	return written, err
+}
+
+func (q *Query) copyIn(ctx context.Context, query string, columns []string, models []interface{}) (int64, error) {
+	stmt, err := q.Tx.PrepareContext(ctx, query)
+
+	if nil != err {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	for _, v := range models {
+		model := v.(IModel)
+		payload := model.MapToPayload(model)
+
+		values := make([]interface{}, len(columns))
+
+		for i, col := range columns {
+			if _, ok := payload[col].(Expression); ok {
+				return 0, fmt.Errorf("column %s: raw expressions are not supported by COPY", col)
+			}
+
+			values[i] = payload[col]
+		}
+
+		if _, err := stmt.ExecContext(ctx, values...); nil != err {
+			return 0, err
+		}
+	}
+
+	if _, err := stmt.ExecContext(ctx); nil != err {
+		return 0, err
+	}
+
+	return int64(len(models)), nil
+}
+
+// bulkModels is a function that will validate the bulk data and prepare every model to be inserted
+func (q *Query) bulkModels(data interface{}) ([]interface{}, error) {
+	value := reflect.ValueOf(data)
+
+	if reflect.Slice != value.Kind() {
+		return nil, errors.New("data must be a slice")
+	}
+
+	models := make([]interface{}, value.Len())
+
+	for i := 0; i < value.Len(); i++ {
+		model, ok := value.Index(i).Interface().(IModel)
+
+		if !ok {
+			return nil, errors.New("data must be a slice of models")
+		}
+
+		if err := prepareInsert(model); nil != err {
+			return nil, err
+		}
+
+		models[i] = model
+	}
+
+	return models, nil
+}
+
+// bulkBatches is a function that will split the models into batches under MaxBindParameters and the configured batch size
+func (q *Query) bulkBatches(models []interface{}) [][]interface{} {
+	var batches [][]interface{}
+	var batch []interface{}
+
+	parameters := 0
+
+	for _, model := range models {
+		count := q.Builder.countInsertParameters(model.(IModel))
+
+		if len(batch) > 0 && (parameters+count > MaxBindParameters || (q.batchSize > 0 && len(batch) >= q.batchSize)) {
+			batches = append(batches, batch)
+			batch = nil
+			parameters = 0
+		}
+
+		batch = append(batch, model)
+		parameters += count
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// bulkInsert is a function that will insert the batches one statement each and scan the returning rows back into the models
+func (q *Query) bulkInsert(batches [][]interface{}, returning []string) (WriteResult, error) {
+	var written WriteResult
+
+	for _, batch := range batches {
+		result, err := q.execute(STMT_INSERT, q.Builder.BuildBulkInsert(q.Model, batch, returning...), q.bulkPayload(batch))
+
+		if nil != err {
+			return written, err
+		}
+
+		if nil == result.Rows {
+			continue
+		}
+
+		err = q.scanReturning(result, batch, &written)
+
+		if nil != err {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+func (q *Query) scanReturning(result Result, batch []interface{}, written *WriteResult) error {
+	defer result.Rows.Close()
+
+	for i := 0; result.Rows.Next(); i++ {
+		if i < len(batch) {
+			model := batch[i].(IModel)
+
+			if err := result.Rows.StructScan(model); nil != err {
+				return err
+			}
+
+			model.SyncOriginal(model)
+		}
+
+		written.RowsAffected++
+	}
+
+	return result.Rows.Err()
+}
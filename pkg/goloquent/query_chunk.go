@@ -0,0 +1,126 @@
+package goloquent
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Each method streams the query results row by row into the callback without loading them all into memory.
+// Returning an error from the callback stops the iteration
+func (q *Query) Each(callback func(m IModel) error) error {
+	defer q.resetBindings()
+
+	result, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
+
+	if nil != err || nil == result.Rows {
+		return err
+	}
+
+	defer result.Rows.Close()
+
+	for result.Rows.Next() {
+		row, err := q.makeTypeOf(q.Model)
+
+		if nil != err {
+			return err
+		}
+
+		if err := result.Rows.StructScan(row); nil != err {
+			return err
+		}
+
+		if err := callback(q.loadModel(row).(IModel)); nil != err {
+			return err
+		}
+	}
+
+	return result.Rows.Err()
+}
+
+// Chunk method streams the query results in batches of the given size, the batch is a slice of the model type.
+// Returning an error from the callback stops the iteration
+func (q *Query) Chunk(size int, callback func(batch interface{}) error) error {
+	if size < 1 {
+		return errors.New("chunk size must be greater than zero")
+	}
+
+	sliceType := reflect.SliceOf(reflect.TypeOf(q.Model))
+	batch := reflect.MakeSlice(sliceType, 0, size)
+
+	err := q.Each(func(m IModel) error {
+		batch = reflect.Append(batch, reflect.ValueOf(m))
+
+		if batch.Len() < size {
+			return nil
+		}
+
+		if err := callback(batch.Interface()); nil != err {
+			return err
+		}
+
+		batch = reflect.MakeSlice(sliceType, 0, size)
+
+		return nil
+	})
+
+	if nil != err || 0 == batch.Len() {
+		return err
+	}
+
+	return callback(batch.Interface())
+}
+
+// ChunkById method iterates the query results in batches of the given size using keyset on the primary key,
+// so rows may be modified safely during the iteration. The offset and limit of the query are ignored.
+// Returning an error from the callback stops the iteration
+func (q *Query) ChunkById(size int, callback func(batch interface{}) error) error {
+	defer q.resetBindings()
+
+	if size < 1 {
+		return errors.New("chunk size must be greater than zero")
+	}
+
+	keys := buildKeySet(nil, q.Model.GetPrimaryKeys()...)
+	binding := q.Binding
+
+	var last *Cursor
+
+	for {
+		q.Binding = binding
+		q.Binding.Conditions = groupConditions(binding.Conditions)
+		q.Binding.Orders = nil
+		q.Binding.Offset = 0
+
+		for _, key := range keys {
+			q.OrderBy(key.direction, key.column)
+		}
+
+		if nil != last {
+			q.Binding.Conditions = append(q.Binding.Conditions, buildKeySetCondition(q.Builder.tableOf(q.Model, q.Binding), keys, last))
+		}
+
+		q.Take(size)
+
+		data, err := q.Get()
+
+		if nil != err {
+			return err
+		}
+
+		rows := reflect.ValueOf(data)
+
+		if 0 == rows.Len() {
+			return nil
+		}
+
+		if err := callback(data); nil != err {
+			return err
+		}
+
+		if rows.Len() < size {
+			return nil
+		}
+
+		last = cursorOf(rows.Index(rows.Len()-1).Interface().(IModel), keys, false)
+	}
+}
@@ -18,7 +18,25 @@ func (q *Query) OrWhere(column string, op Operator, value interface{}) *Query {
 	return q
 }
 
-// WhereIn method verifies that a given column's value is contained within the given array
+// WhereRaw method adds a raw condition, args may be positional for '?' placeholders or a single map for named parameters
+func (q *Query) WhereRaw(raw string, args ...interface{}) *Query {
+	raw, named := newRawArgs(raw, args)
+
+	q.Binding.Conditions = append(q.Binding.Conditions, newRawCondition(AND, raw, named))
+
+	return q
+}
+
+// OrWhereRaw method adds a raw condition, args may be positional for '?' placeholders or a single map for named parameters
+func (q *Query) OrWhereRaw(raw string, args ...interface{}) *Query {
+	raw, named := newRawArgs(raw, args)
+
+	q.Binding.Conditions = append(q.Binding.Conditions, newRawCondition(OR, raw, named))
+
+	return q
+}
+
+// WhereIn method verifies that a given column's value is contained within the given array or subquery
 func (q *Query) WhereIn(column string, value interface{}) *Query {
 	cond := newCondition(AND, column, IN, value)
 
@@ -27,7 +45,7 @@ func (q *Query) WhereIn(column string, value interface{}) *Query {
 	return q
 }
 
-// OrWhereIn method verifies that a given column's value is contained within the given array
+// OrWhereIn method verifies that a given column's value is contained within the given array or subquery
 func (q *Query) OrWhereIn(column string, value interface{}) *Query {
 	cond := newCondition(OR, column, IN, value)
 
@@ -36,7 +54,7 @@ func (q *Query) OrWhereIn(column string, value interface{}) *Query {
 	return q
 }
 
-// Except method verifies that a given column's value is not contained within the given array
+// Except method verifies that a given column's value is not contained within the given array or subquery
 func (q *Query) Except(column string, value interface{}) *Query {
 	cond := newCondition(AND, column, NOT_IN, value)
 
@@ -45,7 +63,7 @@ func (q *Query) Except(column string, value interface{}) *Query {
 	return q
 }
 
-// OrExcept method verifies that a given column's value is not contained within the given array
+// OrExcept method verifies that a given column's value is not contained within the given array or subquery
 func (q *Query) OrExcept(column string, value interface{}) *Query {
 	cond := newCondition(OR, column, NOT_IN, value)
 
@@ -143,3 +161,39 @@ func (q *Query) OrWhereColumn(target string, op Operator, source string) *Query
 
 	return q
 }
+
+// WhereExists method verifies that the given subquery returns at least one row
+func (q *Query) WhereExists(sub *Query) *Query {
+	cond := newCondition(AND, "", EXISTS, sub)
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+// OrWhereExists method verifies that the given subquery returns at least one row
+func (q *Query) OrWhereExists(sub *Query) *Query {
+	cond := newCondition(OR, "", EXISTS, sub)
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+// WhereNotExists method verifies that the given subquery returns no row
+func (q *Query) WhereNotExists(sub *Query) *Query {
+	cond := newCondition(AND, "", NOT_EXISTS, sub)
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+// OrWhereNotExists method verifies that the given subquery returns no row
+func (q *Query) OrWhereNotExists(sub *Query) *Query {
+	cond := newCondition(OR, "", NOT_EXISTS, sub)
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -25,22 +27,27 @@ func (q *Query) Get() (interface{}, error) {
 		return nil, err
 	}
 
-	stmt, args, err := q.prepareNamed(q.ToSQL())
+	result, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
 
-	fmt.Println(err)
-	fmt.Println(q.ToSQL())
-	fmt.Println(args)
+	if nil != err {
+		return nil, err
+	}
 
-	err = stmt.Select(results, args)
+	if nil != result.Rows {
+		err = sqlx.StructScan(result.Rows, results)
+	}
 
 	return q.mapToSliceModel(results), err
 }
 
-// Find .
+// Find is a function that retrieves the row with the given primary key, models with a composite primary key are found by a map of the key columns
 func (q *Query) Find(value interface{}) (interface{}, error) {
 	defer q.resetBindings()
 
-	q.Where(q.Model.GetPK(), EQUAL, value)
+	if err := q.wherePrimaryKey(value); nil != err {
+		return nil, err
+	}
+
 	q.Take(1)
 
 	result, err := q.makeTypeOf(q.Model)
@@ -49,11 +56,15 @@ func (q *Query) Find(value interface{}) (interface{}, error) {
 		return nil, err
 	}
 
-	stmt, args, err := q.prepareNamed(q.ToSQL())
+	rows, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
+
+	if nil != err {
+		return nil, err
+	}
 
-	err = stmt.Get(result, args)
+	err = q.scanOne(rows.Rows, result)
 
-	return q.assignModel(result, q.Model.GetModel()), err
+	return q.loadModel(result), err
 }
 
 // First .
@@ -68,90 +79,376 @@ func (q *Query) First() (interface{}, error) {
 		return nil, err
 	}
 
-	stmt, args, err := q.prepareNamed(q.ToSQL())
+	rows, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
 
-	err = stmt.Get(result, args)
+	if nil != err {
+		return nil, err
+	}
+
+	err = q.scanOne(rows.Rows, result)
 
-	return q.assignModel(result, q.Model.GetModel()), err
+	return q.loadModel(result), err
 }
 
-// Paginate .
-func (q *Query) Paginate(page int, limit ...int) (map[string]interface{}, error) {
+// FindMany is a function that retrieves the rows with the given primary keys, models with a composite primary key take a []map[string]interface{} of the key columns
+func (q *Query) FindMany(values interface{}) (interface{}, error) {
+	pks := q.Model.GetPrimaryKeys()
+
+	if len(pks) == 1 {
+		keys := reflect.ValueOf(values)
+
+		if reflect.Slice != keys.Kind() && reflect.Array != keys.Kind() {
+			q.resetBindings()
+
+			return nil, ErrKeysNotSlice
+		}
+
+		if 0 == keys.Len() {
+			return q.WhereRaw("FALSE").Get()
+		}
+
+		return q.WhereIn(pks[0], values).Get()
+	}
+
+	keys, ok := values.([]map[string]interface{})
+
+	if !ok {
+		q.resetBindings()
+
+		return nil, ErrCompositeKey
+	}
+
+	var rows []string
+	var args []interface{}
+
+	for _, key := range keys {
+		var placeholders []string
+
+		for _, pk := range pks {
+			value, ok := key[pk]
+
+			if !ok {
+				q.resetBindings()
+
+				return nil, ErrCompositeKey
+			}
+
+			placeholders = append(placeholders, "?")
+			args = append(args, value)
+		}
+
+		rows = append(rows, fmt.Sprintf("(%s)", strings.Join(placeholders, ", ")))
+	}
+
+	if len(rows) == 0 {
+		return q.WhereRaw("FALSE").Get()
+	}
+
+	columns := make([]string, len(pks))
+
+	for i, pk := range pks {
+		columns[i] = q.Builder.qualifyColumn(q.Model.GetTableName(), pk)
+	}
+
+	return q.WhereRaw(fmt.Sprintf("(%s) IN (%s)", strings.Join(columns, ", "), strings.Join(rows, ", ")), args...).Get()
+}
+
+// Paginate is a function that paginates the query using offset, the total is counted over the same query without its limit, offset and orders
+func (q *Query) Paginate(page int, limit ...int) (*Paginator, error) {
 	defer q.resetBindings()
 
-	if len(limit) > 0 {
-		q.Take(limit[0])
-	} else {
-		q.Take(50)
+	page, perPage := pageOf(page, limit)
+
+	binding := q.Binding
+
+	q.Take(perPage)
+	q.Skip((page - 1) * perPage)
+
+	data, err := q.Get()
+
+	if nil != err {
+		return nil, err
+	}
+
+	total, err := q.countOf(binding)
+
+	if nil != err {
+		return nil, err
 	}
 
-	q.Skip((page - 1) * q.Binding.Limit)
+	return newPaginator(data, total, perPage, page), nil
+}
+
+// SimplePaginate is a function that paginates the query using offset without counting the total, one extra row is fetched to know whether there is a next page
+func (q *Query) SimplePaginate(page int, limit ...int) (*SimplePaginator, error) {
+	defer q.resetBindings()
+
+	page, perPage := pageOf(page, limit)
+
+	q.Take(perPage + 1)
+	q.Skip((page - 1) * perPage)
 
 	data, err := q.Get()
-	total := q.Count()
 
-	result := map[string]interface{}{
-		"data":  data,
-		"total": total,
+	if nil != err {
+		return nil, err
+	}
+
+	rows := reflect.ValueOf(data)
+	hasMore := rows.Len() > perPage
+
+	if hasMore {
+		rows = rows.Slice(0, perPage)
 	}
 
-	return result, err
+	return newSimplePaginator(rows.Interface(), hasMore, perPage, page), nil
+}
+
+// CursorPaginate is a function that paginates the query using keyset pagination ordered by the query orders and the primary key.
+// An empty cursor will return the first page
+func (q *Query) CursorPaginate(cursor string, limit int) (*CursorPaginator, error) {
+	defer q.resetBindings()
+
+	if limit < 1 {
+		return nil, errors.New("cursor limit must be greater than zero")
+	}
+
+	for _, order := range q.Binding.Orders {
+		if "" != order.Raw {
+			return nil, ErrCursorRawOrder
+		}
+	}
+
+	var position *Cursor
+	var err error
+
+	if "" != cursor {
+		if position, err = DecodeCursor(cursor); nil != err {
+			return nil, err
+		}
+	}
+
+	keys := buildKeySet(q.Binding.Orders, q.Model.GetPrimaryKeys()...)
+
+	if nil != position && len(position.Keys) != len(keys) {
+		return nil, ErrInvalidCursor
+	}
+
+	backward := nil != position && position.Backward
+
+	q.Binding.Orders = nil
+	q.Binding.Conditions = groupConditions(q.Binding.Conditions)
+
+	for i, key := range keys {
+		if backward {
+			keys[i].direction = key.direction.reverse()
+		}
+
+		q.OrderBy(keys[i].direction, key.column)
+	}
+
+	if nil != position {
+		q.Binding.Conditions = append(q.Binding.Conditions, buildKeySetCondition(q.Builder.tableOf(q.Model, q.Binding), keys, position))
+	}
+
+	q.Take(limit + 1)
+
+	data, err := q.Get()
+
+	if nil != err {
+		return nil, err
+	}
+
+	rows := reflect.ValueOf(data)
+	hasMore := rows.Len() > limit
+
+	if hasMore {
+		rows = rows.Slice(0, limit)
+	}
+
+	if backward {
+		swap := reflect.Swapper(rows.Interface())
+
+		for i, j := 0, rows.Len()-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+
+	result := &CursorPaginator{
+		Data: rows.Interface(),
+	}
+
+	if rows.Len() == 0 {
+		return result, nil
+	}
+
+	first := rows.Index(0).Interface().(IModel)
+	last := rows.Index(rows.Len() - 1).Interface().(IModel)
+
+	if hasMore || backward {
+		result.NextCursor = cursorOf(last, keys, false).Encode()
+	}
+
+	if (backward && hasMore) || (!backward && "" != cursor) {
+		result.PrevCursor = cursorOf(first, keys, true).Encode()
+	}
+
+	return result, nil
 }
 
 // Insert .
 func (q *Query) Insert(returning ...string) (interface{}, error) {
-	var result *sqlx.Rows
-	var err error
+	if err := prepareInsert(q.Model); nil != err {
+		return q.Model, err
+	}
 
 	query := q.Builder.BuildInsert(q.Model, returning...)
 
-	q.Model.SetCreated()
-
 	payload := q.Model.MapToPayload(q.Model)
 
-	if nil != q.Tx {
-		result, err = q.Tx.NamedQuery(query, payload)
-	} else {
-		result, err = q.DB.NamedQuery(query, payload)
+	q.Builder.mergePayload(payload, q.Builder.buildExpressionPayload("e_", payload))
+
+	result, err := q.execute(STMT_INSERT, query, payload)
+
+	if nil != err || nil == result.Rows {
+		return q.Model, err
 	}
 
-	if nil != result && result.Next() {
-		result.StructScan(q.Model)
+	defer result.Rows.Close()
+
+	if result.Rows.Next() {
+		err = result.Rows.StructScan(q.Model)
+	}
+
+	if nil == err {
+		q.Model.SyncOriginal(q.Model)
 	}
 
 	return q.Model, err
 }
 
-// Update .
-func (q *Query) Update() (bool, error) {
-	var err error
+// Update is a function that updates the changed columns of the model, the rows affected are zero when nothing changed
+func (q *Query) Update() (WriteResult, error) {
+	defer q.resetBindings()
+
+	dirty := q.Model.GetDirty(q.Model)
+
+	if nil == q.Model.GetOriginal() {
+		delete(dirty, CREATED_AT)
+	}
+
+	for _, pk := range q.Model.GetPrimaryKeys() {
+		delete(dirty, pk)
+	}
+
+	if q.Model.IsVersioned() {
+		delete(dirty, q.Model.GetVersionColumn())
+	}
 
-	query := q.Builder.BuildUpdate(q.Model)
+	if len(dirty) == 0 {
+		return WriteResult{}, nil
+	}
 
 	q.Model.SetUpdated()
 
-	payload := q.Model.MapToPayload(q.Model)
+	columns := sortedKeys(dirty)
+
+	if _, ok := dirty[UPDATED_AT]; q.Model.IsTimestamp() && !ok {
+		columns = append(columns, UPDATED_AT)
+	}
+
+	version := q.Model.GetVersion(q.Model)
+
+	if q.Model.IsVersioned() {
+		if err := q.Model.SetVersion(q.Model, version+1); nil != err {
+			return WriteResult{}, err
+		}
 
-	if nil != q.Tx {
-		_, err = q.Tx.NamedQuery(query, payload)
-	} else {
-		_, err = q.DB.NamedQuery(query, payload)
+		columns = append(columns, q.Model.GetVersionColumn())
 	}
 
+	query := q.Builder.BuildUpdate(q.Model, q.Binding, columns)
+
+	payload := q.Builder.buildWithPayload(q.Binding)
+
+	values := q.Model.MapToPayload(q.Model)
+
+	q.Builder.mergePayload(payload, values)
+	q.Builder.mergePayload(payload, q.Builder.buildExpressionPayload("e_", values))
+
+	if q.Model.IsVersioned() {
+		payload[fmt.Sprintf("old_%s", q.Model.GetVersionColumn())] = version
+	}
+
+	result, err := q.execute(STMT_UPDATE, query, payload)
+
 	if nil != err {
-		return false, err
+		q.Model.SetVersion(q.Model, version)
+
+		return WriteResult{}, err
+	}
+
+	written, err := writeResultOf(result)
+
+	if nil == err && q.Model.IsVersioned() && nil != result.Result && 0 == written.RowsAffected {
+		err = ErrStaleObject
 	}
 
-	return true, nil
+	if nil != err {
+		q.Model.SetVersion(q.Model, version)
+
+		return written, err
+	}
+
+	q.Model.SyncOriginal(q.Model)
+
+	return written, nil
 }
 
-// Delete .
-func (q *Query) Delete() (bool, error) {
-	var err error
+// UpdateColumns is a function that updates the given columns of every row matching the query conditions, values may contain Raw expressions
+func (q *Query) UpdateColumns(values map[string]interface{}) (WriteResult, error) {
+	defer q.resetBindings()
 
-	query := q.Builder.BuildDelete(q.Model, nil)
+	values = q.Builder.copyPayload(values)
 
-	payload := q.Model.MapToPayload(q.Model)
+	if _, ok := values[UPDATED_AT]; q.Model.IsTimestamp() && !ok {
+		values[UPDATED_AT] = time.Now()
+	}
+
+	if _, ok := values[q.Model.GetVersionColumn()]; q.Model.IsVersioned() && !ok {
+		values[q.Model.GetVersionColumn()] = Raw(fmt.Sprintf(`"%s" + 1`, q.Model.GetVersionColumn()))
+	}
+
+	query := q.Builder.BuildUpdateColumns(q.Model, q.Binding, values)
+
+	payload := q.mapConditionPayload()
+
+	for col, value := range values {
+		if _, ok := value.(Expression); !ok {
+			payload[fmt.Sprintf("u_%s", col)] = value
+		}
+	}
+
+	q.Builder.mergePayload(payload, q.Builder.buildExpressionPayload("u_", values))
+
+	result, err := q.execute(STMT_UPDATE, query, payload)
+
+	if nil != err {
+		return WriteResult{}, err
+	}
+
+	return writeResultOf(result)
+}
+
+// Delete is a function that deletes the model, soft deletable models are updated instead
+func (q *Query) Delete() (WriteResult, error) {
+	defer q.resetBindings()
+
+	query := q.Builder.BuildDelete(q.Model, Binding{CTEs: q.Binding.CTEs})
+
+	payload := q.Builder.buildWithPayload(q.Binding)
+
+	q.Builder.mergePayload(payload, q.Model.MapToPayload(q.Model))
 
 	if q.Model.IsSoftDelete() {
 		q.Model.SetDeleted()
@@ -159,65 +456,55 @@ func (q *Query) Delete() (bool, error) {
 		return q.Update()
 	}
 
-	if nil != q.Tx {
-		_, err = q.Tx.NamedQuery(query, payload)
-	} else {
-		_, err = q.DB.NamedQuery(query, payload)
-	}
+	result, err := q.execute(STMT_DELETE, query, payload)
 
 	if nil != err {
-		return false, err
+		return WriteResult{}, err
 	}
 
-	return true, nil
+	return writeResultOf(result)
 }
 
-// BulkInsert .
-func (q *Query) BulkInsert(data interface{}, returning ...string) (bool, error) {
-	var err error
-	var value reflect.Value
+// BulkInsert is a function that inserts every model of the slice, the returning columns are scanned back into the models in order.
+// Rows are inserted in batches under MaxBindParameters, several batches are executed within a single transaction
+func (q *Query) BulkInsert(data interface{}, returning ...string) (WriteResult, error) {
+	defer q.resetBindings()
 
-	value = reflect.ValueOf(data)
+	models, err := q.bulkModels(data)
 
-	if reflect.Slice != value.Kind() {
-		return false, errors.New("data must be a slice")
+	if nil != err {
+		return WriteResult{}, err
 	}
 
-	slice := make([]interface{}, value.Len())
+	batches := q.bulkBatches(models)
 
-	for i := 0; i < value.Len(); i++ {
-		slice[i] = value.Index(i).Interface()
+	if len(batches) < 2 {
+		return q.bulkInsert(batches, returning)
 	}
 
-	query := q.Builder.BuildBulkInsert(q.Model, slice, returning...)
-	payloads := q.bulkPayload(slice)
+	var written WriteResult
 
-	if nil != q.Tx {
-		_, err = q.Tx.NamedQuery(query, payloads)
-	} else {
-		_, err = q.DB.NamedQuery(query, payloads)
-	}
+	err = q.transaction(func() error {
+		written, err = q.bulkInsert(batches, returning)
 
-	if nil != err {
-		return false, err
-	}
+		return err
+	})
 
-	return true, nil
+	return written, err
 }
 
 // RawCommand .
 func (q *Query) RawCommand(dest IModel, query string, args interface{}) (interface{}, error) {
-	var result *sqlx.Rows
-	var err error
+	result, err := q.execute(STMT_RAW, query, args)
 
-	if nil != q.Tx {
-		result, err = q.Tx.NamedQuery(query, args)
-	} else {
-		result, err = q.DB.NamedQuery(query, args)
+	if nil != err || nil == result.Rows {
+		return dest, err
 	}
 
-	if nil != result && result.Next() {
-		result.StructScan(dest)
+	defer result.Rows.Close()
+
+	if result.Rows.Next() {
+		err = result.Rows.StructScan(dest)
 	}
 
 	return dest, err
@@ -225,9 +512,11 @@ func (q *Query) RawCommand(dest IModel, query string, args interface{}) (interfa
 
 // RawQuery .
 func (q *Query) RawQuery(dest IModel, query string, args ...interface{}) error {
-	var err error
+	result, err := q.execute(STMT_RAW, query, args)
 
-	err = q.DB.Select(dest, query, args...)
+	if nil != err || nil == result.Rows {
+		return err
+	}
 
-	return err
+	return sqlx.StructScan(result.Rows, dest)
 }
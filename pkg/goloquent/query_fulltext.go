@@ -0,0 +1,34 @@
+package goloquent
+
+import "fmt"
+
+// WhereFullText method verifies that the columns match the search term using full text search
+func (q *Query) WhereFullText(columns []string, term string, opts ...FullTextOptions) *Query {
+	return q.whereFullText(AND, columns, term, opts)
+}
+
+// OrWhereFullText method verifies that the columns match the search term using full text search
+func (q *Query) OrWhereFullText(columns []string, term string, opts ...FullTextOptions) *Query {
+	return q.whereFullText(OR, columns, term, opts)
+}
+
+// OrderByRank method sorts the result of the query by the full text search rank of the columns, the most relevant first
+func (q *Query) OrderByRank(columns []string, term string, opts ...FullTextOptions) *Query {
+	options := fullTextOptionsOf(opts)
+
+	expression := fmt.Sprintf("ts_rank(%s, %s) DESC", buildTsVector(q.Builder.tableOf(q.Model, q.Binding), columns, options), buildTsQuery(options))
+
+	return q.OrderByRaw(expression, map[string]interface{}{"term": term})
+}
+
+func (q *Query) whereFullText(connector Connector, columns []string, term string, opts []FullTextOptions) *Query {
+	options := fullTextOptionsOf(opts)
+
+	query := fmt.Sprintf("%s @@ %s", buildTsVector(q.Builder.tableOf(q.Model, q.Binding), columns, options), buildTsQuery(options))
+
+	cond := newRawCondition(connector, query, map[string]interface{}{"term": term})
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
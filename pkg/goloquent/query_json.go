@@ -0,0 +1,76 @@
+package goloquent
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// WhereJsonContains method verifies that a JSONB column contains the given value, a value that can not be marshalled is reported when the query is executed
+func (q *Query) WhereJsonContains(column string, value interface{}) *Query {
+	return q.whereJsonContains(AND, column, value)
+}
+
+// OrWhereJsonContains method verifies that a JSONB column contains the given value
+func (q *Query) OrWhereJsonContains(column string, value interface{}) *Query {
+	return q.whereJsonContains(OR, column, value)
+}
+
+// WhereJsonPath method compares the value at a json path with a value, e.g. WhereJsonPath("meta->settings->>theme", EQUAL, "dark")
+func (q *Query) WhereJsonPath(path string, op Operator, value interface{}) *Query {
+	return q.whereJsonPath(AND, path, op, value)
+}
+
+// OrWhereJsonPath method compares the value at a json path with a value, e.g. OrWhereJsonPath("meta->settings->>theme", EQUAL, "dark")
+func (q *Query) OrWhereJsonPath(path string, op Operator, value interface{}) *Query {
+	return q.whereJsonPath(OR, path, op, value)
+}
+
+// WhereJsonLength method compares the length of the json array at a column or path with a value
+func (q *Query) WhereJsonLength(path string, op Operator, value interface{}) *Query {
+	return q.whereJsonLength(AND, path, op, value)
+}
+
+// OrWhereJsonLength method compares the length of the json array at a column or path with a value
+func (q *Query) OrWhereJsonLength(path string, op Operator, value interface{}) *Query {
+	return q.whereJsonLength(OR, path, op, value)
+}
+
+func (q *Query) whereJsonContains(connector Connector, column string, value interface{}) *Query {
+	var argument interface{}
+
+	raw, err := json.Marshal(value)
+
+	if nil != err {
+		argument = invalidArgument{err: err}
+	} else {
+		argument = string(raw)
+	}
+
+	query := fmt.Sprintf(`%s @> CAST(:value AS JSONB)`, buildJsonPath(q.Builder.tableOf(q.Model, q.Binding), column))
+
+	cond := newRawCondition(connector, query, map[string]interface{}{"value": argument})
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+func (q *Query) whereJsonPath(connector Connector, path string, op Operator, value interface{}) *Query {
+	query := fmt.Sprintf(`%s %s :value`, buildJsonPath(q.Builder.tableOf(q.Model, q.Binding), path), op)
+
+	cond := newRawCondition(connector, query, map[string]interface{}{"value": value})
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
+
+func (q *Query) whereJsonLength(connector Connector, path string, op Operator, value interface{}) *Query {
+	query := fmt.Sprintf(`jsonb_array_length(CAST(%s AS JSONB)) %s :value`, buildJsonPath(q.Builder.tableOf(q.Model, q.Binding), path), op)
+
+	cond := newRawCondition(connector, query, map[string]interface{}{"value": value})
+
+	q.Binding.Conditions = append(q.Binding.Conditions, cond)
+
+	return q
+}
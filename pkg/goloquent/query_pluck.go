@@ -0,0 +1,134 @@
+package goloquent
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Exists is a function that checks whether any row matches the query using SELECT EXISTS(...)
+func (q *Query) Exists() (bool, error) {
+	defer q.resetBindings()
+
+	var exists bool
+
+	rows, err := q.execute(STMT_SELECT, q.Builder.BuildExists(q.Model, q.Binding), q.mapConditionPayload())
+
+	if nil != err {
+		return false, err
+	}
+
+	err = q.scanOne(rows.Rows, &exists)
+
+	return exists, err
+}
+
+// DoesntExist is a function that checks whether no row matches the query
+func (q *Query) DoesntExist() (bool, error) {
+	exists, err := q.Exists()
+
+	return !exists, err
+}
+
+// Pluck is a function that retrieves the values of a single column of every matching row
+func (q *Query) Pluck(column string) ([]interface{}, error) {
+	defer q.resetBindings()
+
+	q.Binding.Columns = []string{column}
+
+	rows, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
+
+	if nil != err || nil == rows.Rows {
+		return nil, err
+	}
+
+	defer rows.Rows.Close()
+
+	var values []interface{}
+
+	for rows.Rows.Next() {
+		var value interface{}
+
+		if err := rows.Rows.Scan(&value); nil != err {
+			return nil, err
+		}
+
+		values = append(values, normalizeValue(value))
+	}
+
+	return values, rows.Rows.Err()
+}
+
+// PluckMap is a function that retrieves the value column of every matching row keyed by the key column
+func (q *Query) PluckMap(key string, value string) (map[interface{}]interface{}, error) {
+	defer q.resetBindings()
+
+	q.Binding.Columns = []string{key, value}
+
+	rows, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
+
+	if nil != err || nil == rows.Rows {
+		return nil, err
+	}
+
+	defer rows.Rows.Close()
+
+	values := map[interface{}]interface{}{}
+
+	for rows.Rows.Next() {
+		var k, v interface{}
+
+		if err := rows.Rows.Scan(&k, &v); nil != err {
+			return nil, err
+		}
+
+		values[normalizeValue(k)] = normalizeValue(v)
+	}
+
+	return values, rows.Rows.Err()
+}
+
+// Value is a function that retrieves a single column of the first matching row, sql.ErrNoRows is returned when there is none
+func (q *Query) Value(column string) (interface{}, error) {
+	defer q.resetBindings()
+
+	var value interface{}
+
+	q.Binding.Columns = []string{column}
+	q.Take(1)
+
+	rows, err := q.execute(STMT_SELECT, q.ToSQL(), q.mapConditionPayload())
+
+	if nil != err {
+		return nil, err
+	}
+
+	err = q.scanOne(rows.Rows, &value)
+
+	return normalizeValue(value), err
+}
+
+// Implode is a function that concatenates the values of a single column of every matching row using glue
+func (q *Query) Implode(column string, glue string) (string, error) {
+	values, err := q.Pluck(column)
+
+	if nil != err {
+		return "", err
+	}
+
+	items := make([]string, len(values))
+
+	for i, value := range values {
+		items[i] = fmt.Sprint(value)
+	}
+
+	return strings.Join(items, glue), nil
+}
+
+// normalizeValue converts the raw bytes returned by the driver for text and numeric columns into a string
+func normalizeValue(value interface{}) interface{} {
+	if b, ok := value.([]byte); ok {
+		return string(b)
+	}
+
+	return value
+}
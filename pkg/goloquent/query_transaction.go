@@ -31,3 +31,27 @@ func (q *Query) EndTransaction() *Query {
 	q.Tx = nil
 	return q
 }
+
+// transaction is a function that will run fn within a new transaction unless one is already active
+func (q *Query) transaction(fn func() error) error {
+	if nil != q.Tx {
+		return fn()
+	}
+
+	tx, err := q.DB.BeginTxx(q.context(), nil)
+
+	if nil != err {
+		return err
+	}
+
+	q.Tx = tx
+	defer q.EndTransaction()
+
+	if err := fn(); nil != err {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
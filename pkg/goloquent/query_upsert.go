@@ -0,0 +1,273 @@
+package goloquent
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ErrRecordNotFound is returned by the OrFail executors when no row matches the query
+var ErrRecordNotFound = errors.New("record not found")
+
+// uniqueConstraints caches whether a unique index covers the columns of a table, keyed by uniqueConstraintKey
+var uniqueConstraints sync.Map
+
+type uniqueConstraintKey struct {
+	db      *sqlx.DB
+	table   string
+	columns string
+}
+
+// FindOrFail is a function that finds a row by its primary key, ErrRecordNotFound is returned when there is none
+func (q *Query) FindOrFail(value interface{}) (interface{}, error) {
+	result, err := q.Find(value)
+
+	if sql.ErrNoRows == err {
+		return nil, ErrRecordNotFound
+	}
+
+	return result, err
+}
+
+// FirstOrFail is a function that retrieves the first row matching the query, ErrRecordNotFound is returned when there is none
+func (q *Query) FirstOrFail() (interface{}, error) {
+	result, err := q.First()
+
+	if sql.ErrNoRows == err {
+		return nil, ErrRecordNotFound
+	}
+
+	return result, err
+}
+
+// FirstOrNew is a function that retrieves the first row matching attrs, when there is none a new model filled with attrs and values is returned without being saved
+func (q *Query) FirstOrNew(attrs map[string]interface{}, values map[string]interface{}) (interface{}, error) {
+	result, err := q.whereAttributes(attrs).First()
+
+	if sql.ErrNoRows != err {
+		return result, err
+	}
+
+	return q.newModel(attrs, values)
+}
+
+// FirstOrCreate is a function that retrieves the first row matching attrs, when there is none a row filled with attrs and values is inserted
+func (q *Query) FirstOrCreate(attrs map[string]interface{}, values map[string]interface{}) (interface{}, error) {
+	result, err := q.whereAttributes(attrs).First()
+
+	if sql.ErrNoRows != err {
+		return result, err
+	}
+
+	model, err := q.newModel(attrs, values)
+
+	if nil != err {
+		return nil, err
+	}
+
+	return q.insertModel(model)
+}
+
+// UpdateOrCreate is a function that updates the row matching attrs with values or inserts it when there is none.
+// An upsert is used when a unique constraint covers attrs, otherwise the row is locked in a transaction
+// and concurrent calls with the same attrs are serialised by a transaction advisory lock
+func (q *Query) UpdateOrCreate(attrs map[string]interface{}, values map[string]interface{}) (interface{}, error) {
+	defer q.resetBindings()
+
+	conflict := sortedKeys(attrs)
+
+	unique, err := q.hasUniqueConstraint(conflict)
+
+	if nil != err {
+		return nil, err
+	}
+
+	if !unique {
+		return q.updateOrCreateLocked(attrs, values)
+	}
+
+	model, err := q.newModel(attrs, values)
+
+	if nil != err {
+		return nil, err
+	}
+
+	if err := prepareInsert(model); nil != err {
+		return nil, err
+	}
+
+	update := sortedKeys(values)
+
+	if model.IsTimestamp() {
+		update = append(update, UPDATED_AT)
+	}
+
+	if len(update) == 0 {
+		update = conflict
+	}
+
+	payload := model.MapToPayload(model)
+
+	q.Builder.mergePayload(payload, q.Builder.buildExpressionPayload("e_", payload))
+
+	result, err := q.execute(STMT_INSERT, q.Builder.BuildUpsert(model, conflict, update), payload)
+
+	if nil != err {
+		return nil, err
+	}
+
+	return model, q.scanOne(result.Rows, model)
+}
+
+func (q *Query) updateOrCreateLocked(attrs map[string]interface{}, values map[string]interface{}) (interface{}, error) {
+	var result interface{}
+
+	err := q.transaction(func() error {
+		_, err := AdvisoryXactLock(q.context(), q, q.attributesKey(attrs))
+
+		if nil != err {
+			return err
+		}
+
+		result, err = q.updateOrCreate(attrs, values)
+
+		return err
+	})
+
+	return result, err
+}
+
+func (q *Query) updateOrCreate(attrs map[string]interface{}, values map[string]interface{}) (interface{}, error) {
+	result, err := q.whereAttributes(attrs).LockForUpdate().First()
+
+	if sql.ErrNoRows == err {
+		model, err := q.newModel(attrs, values)
+
+		if nil != err {
+			return nil, err
+		}
+
+		return q.insertModel(model)
+	}
+
+	if nil != err {
+		return nil, err
+	}
+
+	model := result.(IModel)
+
+	if err := fillModel(model, values); nil != err {
+		return nil, err
+	}
+
+	origin := q.Model
+	defer func() { q.Model = origin }()
+
+	q.Model = model
+
+	_, err = q.Update()
+
+	return model, err
+}
+
+// attributesKey is a function that derives the advisory lock key of the model table row identified by attrs
+func (q *Query) attributesKey(attrs map[string]interface{}) int64 {
+	parts := []string{q.Model.GetTableName()}
+
+	for _, col := range sortedKeys(attrs) {
+		parts = append(parts, fmt.Sprintf("%s=%v", col, attrs[col]))
+	}
+
+	return AdvisoryKey(strings.Join(parts, ":"))
+}
+
+// hasUniqueConstraint is a function that checks whether a unique index of the model table covers exactly the given columns.
+// The table is resolved through the search path, partial and expression indexes are ignored since they can not be used as a conflict target.
+// Index columns are sorted bytewise like sortedKeys, regardless of the database collation. The result is cached per database, table and columns
+func (q *Query) hasUniqueConstraint(columns []string) (bool, error) {
+	key := uniqueConstraintKey{db: q.DB, table: q.Model.GetTableName(), columns: strings.Join(columns, ",")}
+
+	if cached, ok := uniqueConstraints.Load(key); ok {
+		return cached.(bool), nil
+	}
+
+	var exists bool
+
+	query := `SELECT EXISTS(
+		SELECT 1 FROM pg_index i
+		WHERE i.indrelid = to_regclass($1) AND i.indisunique AND i.indpred IS NULL AND i.indexprs IS NULL AND (
+			SELECT array_agg(a.attname::TEXT ORDER BY a.attname::TEXT COLLATE "C") FROM pg_attribute a
+			WHERE a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		) = $2::TEXT[]
+	)`
+
+	result, err := q.execute(STMT_RAW, query, []interface{}{key.table, pq.Array(columns)})
+
+	if nil != err {
+		return false, err
+	}
+
+	if err := q.scanOne(result.Rows, &exists); nil != err {
+		return false, err
+	}
+
+	uniqueConstraints.Store(key, exists)
+
+	return exists, nil
+}
+
+func (q *Query) whereAttributes(attrs map[string]interface{}) *Query {
+	for _, col := range sortedKeys(attrs) {
+		q.Where(col, EQUAL, attrs[col])
+	}
+
+	return q
+}
+
+// newModel is a function that will create a new model of the query model type filled with attrs and values
+func (q *Query) newModel(attrs map[string]interface{}, values map[string]interface{}) (IModel, error) {
+	result, err := q.makeTypeOf(q.Model)
+
+	if nil != err {
+		return nil, err
+	}
+
+	model := q.assignModel(result, q.Model.GetModel()).(IModel)
+
+	if err := fillModel(model, attrs); nil != err {
+		return nil, err
+	}
+
+	if err := fillModel(model, values); nil != err {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (q *Query) insertModel(model IModel) (interface{}, error) {
+	origin := q.Model
+	defer func() { q.Model = origin }()
+
+	q.Model = model
+
+	return q.Insert()
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,194 @@
+package goloquent
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// invalidArgument is the value bound to a named parameter whose argument could not be built, execute reports its error
+type invalidArgument struct {
+	err error
+}
+
+// Expression is a struct for wrapping a raw SQL expression that is written into the statement as is
+type Expression struct {
+	SQL  string
+	Args map[string]interface{}
+}
+
+// Raw is a function that creates a raw SQL expression, it may be used as value of conditions, inserts and updates.
+// args may be positional for '?' placeholders or a single map for named parameters
+func Raw(sql string, args ...interface{}) Expression {
+	sql, named := newRawArgs(sql, args)
+
+	return Expression{SQL: sql, Args: named}
+}
+
+// bind is a function that returns the SQL and arguments of the expression with every named parameter prefixed by key
+func (e Expression) bind(key string) (string, map[string]interface{}) {
+	return bindRaw(e.SQL, key, e.Args)
+}
+
+// newRawArgs is a function that maps the arguments of a raw fragment into named arguments.
+// A single map argument is used as named arguments, otherwise every '?' placeholder is bound to the positional arguments
+func newRawArgs(raw string, args []interface{}) (string, map[string]interface{}) {
+	if 1 == len(args) {
+		if named, ok := args[0].(map[string]interface{}); ok {
+			return raw, withMissingArguments(raw, named)
+		}
+	}
+
+	var query strings.Builder
+
+	named := map[string]interface{}{}
+	position := 0
+	inQuote := false
+
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+
+		switch {
+		case '\'' == c:
+			inQuote = !inQuote
+			query.WriteByte(c)
+		case '?' == c && !inQuote && position < len(args):
+			name := fmt.Sprintf("%d", position)
+
+			named[name] = args[position]
+			position++
+
+			query.WriteString(":" + name)
+		default:
+			query.WriteByte(c)
+		}
+	}
+
+	return query.String(), withMissingArguments(query.String(), named)
+}
+
+// withMissingArguments is a function that will mark every named placeholder of the raw fragment without argument as missing
+func withMissingArguments(raw string, args map[string]interface{}) map[string]interface{} {
+	var named map[string]interface{}
+
+	for _, name := range rawParameters(raw) {
+		if _, ok := args[name]; ok {
+			continue
+		}
+
+		if nil == named {
+			named = make(map[string]interface{}, len(args))
+
+			for key, value := range args {
+				named[key] = value
+			}
+		}
+
+		named[name] = invalidArgument{err: fmt.Errorf("raw expression has no argument for the placeholder :%s", name)}
+	}
+
+	if nil == named {
+		return args
+	}
+
+	return named
+}
+
+// rawParameters is a function that returns the named placeholders of a raw fragment, skipping casts and quoted literals
+func rawParameters(raw string) []string {
+	var names []string
+
+	inQuote := false
+
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+
+		switch {
+		case '\'' == c:
+			inQuote = !inQuote
+		case ':' == c && inQuote:
+		case ':' == c && i+1 < len(raw) && ':' == raw[i+1]:
+			i++
+		case ':' == c && i+1 < len(raw) && isNameChar(raw[i+1]):
+			j := i + 1
+			for j < len(raw) && isNameChar(raw[j]) {
+				j++
+			}
+
+			names = append(names, raw[i+1:j])
+			i = j - 1
+		}
+	}
+
+	return names
+}
+
+// invalidArgumentOf is a function that reports the error of the first invalid argument of the statement arguments
+func invalidArgumentOf(args interface{}) error {
+	payload, ok := args.(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	var keys []string
+
+	for key, value := range payload {
+		if _, ok := value.(invalidArgument); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	sort.Strings(keys)
+
+	return payload[keys[0]].(invalidArgument).err
+}
+
+// bindRaw is a function that prefixes every named parameter of a raw fragment to avoid collision with the rest of the statement.
+// '::' casts and colons inside quoted literals are escaped so they survive named parameter compilation
+func bindRaw(raw string, prefix string, args map[string]interface{}) (string, map[string]interface{}) {
+	var query strings.Builder
+
+	payload := map[string]interface{}{}
+	inQuote := false
+
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+
+		switch {
+		case '\'' == c:
+			inQuote = !inQuote
+			query.WriteByte(c)
+		case ':' == c && inQuote:
+			query.WriteString("::")
+		case ':' == c && i+1 < len(raw) && ':' == raw[i+1]:
+			query.WriteString("::::")
+			i++
+		case ':' == c && i+1 < len(raw) && isNameChar(raw[i+1]):
+			j := i + 1
+			for j < len(raw) && isNameChar(raw[j]) {
+				j++
+			}
+
+			name := raw[i+1 : j]
+			key := fmt.Sprintf("%s_%s", prefix, name)
+
+			payload[key] = args[name]
+
+			query.WriteString(":" + key)
+			i = j - 1
+		default:
+			query.WriteByte(c)
+		}
+	}
+
+	return query.String(), payload
+}
+
+func isNameChar(c byte) bool {
+	return '_' == c || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
@@ -0,0 +1,67 @@
+package goloquent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaw_PositionalArgs(t *testing.T) {
+	raw, args := newRawArgs("date_trunc('day', created_at) = ? AND name <> '?' AND id > ?", []interface{}{"2019-01-01", 10})
+
+	t.Run("TestRaw_POSITIONAL", func(t *testing.T) {
+		require.Equal(t, "date_trunc('day', created_at) = :0 AND name <> '?' AND id > :1", raw)
+		require.Equal(t, map[string]interface{}{"0": "2019-01-01", "1": 10}, args)
+	})
+}
+
+func TestRaw_NamedArgs(t *testing.T) {
+	raw, args := newRawArgs("created_at::date = :d", []interface{}{map[string]interface{}{"d": "2019-01-01"}})
+
+	t.Run("TestRaw_NAMED", func(t *testing.T) {
+		require.Equal(t, "created_at::date = :d", raw)
+		require.Equal(t, map[string]interface{}{"d": "2019-01-01"}, args)
+	})
+
+	t.Run("TestRaw_BIND", func(t *testing.T) {
+		bound, payload := bindRaw(raw+" AND at <> '12:30'", "3", args)
+
+		require.Equal(t, "created_at::::date = :3_d AND at <> '12::30'", bound)
+		require.Equal(t, map[string]interface{}{"3_d": "2019-01-01"}, payload)
+	})
+}
+
+func TestRaw_MissingArgs(t *testing.T) {
+	t.Run("TestRaw_MISSING_NAMED", func(t *testing.T) {
+		_, named := newRawArgs("name = :nmae", []interface{}{map[string]interface{}{"name": "a"}})
+
+		require.EqualError(t, named["nmae"].(invalidArgument).err, "raw expression has no argument for the placeholder :nmae")
+	})
+
+	t.Run("TestRaw_CAST_AND_LITERAL", func(t *testing.T) {
+		_, named := newRawArgs("created_at::DATE = ? AND note <> 'a:b'", []interface{}{"2020-01-01"})
+
+		require.Equal(t, map[string]interface{}{"0": "2020-01-01"}, named)
+	})
+
+	t.Run("TestRaw_EXECUTE", func(t *testing.T) {
+		db, fake := newFakeDB(t, nil)
+
+		queries := map[string]*Query{
+			"WhereRaw":   DB(db).Use(genreModel()).WhereRaw("name = :nmae", map[string]interface{}{"name": "a"}),
+			"OrWhereRaw": DB(db).Use(genreModel()).OrWhereRaw("name = :nmae"),
+			"HavingRaw":  DB(db).Use(genreModel()).Select("name").GroupBy("name").HavingRaw("COUNT(*) > :nmae"),
+			"OrderByRaw": DB(db).Use(genreModel()).OrderByRaw("POSITION(:nmae IN name)"),
+			"Raw":        DB(db).Use(genreModel()).Where("name", EQUAL, Raw("LOWER(:nmae)")),
+			"SubQuery":   DB(db).Use(genreModel()).WhereIn("id", DB(db).Use(genreModel()).Select("id").WhereRaw("name = :nmae")),
+		}
+
+		for name, query := range queries {
+			_, err := query.Get()
+
+			require.EqualError(t, err, "raw expression has no argument for the placeholder :nmae", name)
+		}
+
+		require.Empty(t, fake.Statements)
+	})
+}
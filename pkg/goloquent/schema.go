@@ -13,6 +13,7 @@ type Schema struct {
 	references  []*Reference
 	uniques     []string
 	indexes     []string
+	fullTexts   []*FullText
 }
 
 // Create is a command for create a table
@@ -145,6 +146,20 @@ func (s *Schema) JSON(name string) *Column {
 	return s.addColumn(col)
 }
 
+// JSONB is a Schema Command for create Schema Column
+func (s *Schema) JSONB(name string) *Column {
+	col := newColumn(name, DT_JSONB)
+
+	return s.addColumn(col)
+}
+
+// Array is a Schema Command for create Schema Column of array of the given data type
+func (s *Schema) Array(name string, dt DataType) *Column {
+	col := newColumn(name, ArrayOf(dt))
+
+	return s.addColumn(col)
+}
+
 // Boolean is a Schema Command for create Schema Column
 func (s *Schema) Boolean(name string) *Column {
 	col := newColumn(name, DT_BOOL)
@@ -192,6 +207,22 @@ func (s *Schema) Index(columns ...string) {
 	s.indexes = append(s.indexes, columns...)
 }
 
+// FullTextIndex is a Schema Command for add GIN index on the text search vector of the columns
+func (s *Schema) FullTextIndex(columns ...string) *FullText {
+	fullText := newFullText(columns...)
+
+	s.fullTexts = append(s.fullTexts, fullText)
+
+	return fullText
+}
+
+// TsVector is a Schema Command for create Schema Column
+func (s *Schema) TsVector(name string) *Column {
+	col := newColumn(name, DT_TSVECTOR)
+
+	return s.addColumn(col)
+}
+
 // Timestamp is a Schema Command for create Schema Column
 func (s *Schema) Timestamp() {
 	s.addColumn(newColumn("created_at", DT_TIMESTAMP))
@@ -214,6 +245,11 @@ func (s *Schema) SoftDeleteTz() {
 	s.addColumn(newColumn("deleted_at", DT_TIMESTAMPTZ))
 }
 
+// Version is a Schema Command for create Schema 'version' column used by optimistic locking
+func (s *Schema) Version() {
+	s.addColumn(newColumn(VERSION, DT_BIGINT).NotNull().DefaultValue(1))
+}
+
 // Rename is a Schema Command for Renaming Schema Column
 func (s *Schema) Rename(from string, to string) {
 	col := renameColumn(from, to)
@@ -0,0 +1,51 @@
+package goloquent
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Statement is a struct that is used to store information about a statement before it is executed
+type Statement struct {
+	Kind  StatementKind
+	Table string
+	Query string
+	Args  interface{}
+}
+
+// Result is a struct that is used to store the outcome of an executed statement
+type Result struct {
+	Rows   *sqlx.Rows
+	Result sql.Result
+}
+
+// WriteResult is a struct that is used to store the outcome of a write executor
+type WriteResult struct {
+	RowsAffected int64
+}
+
+func newStatement(kind StatementKind, table string, query string, args interface{}) Statement {
+	return Statement{
+		Kind:  kind,
+		Table: table,
+		Query: query,
+		Args:  args,
+	}
+}
+
+// IsQuery reports whether the statement produces rows that has to be scanned
+func (s Statement) IsQuery() bool {
+	return STMT_UPDATE != s.Kind && STMT_DELETE != s.Kind
+}
+
+// writeResultOf is a function that will read the rows affected by an executed statement
+func writeResultOf(result Result) (WriteResult, error) {
+	if nil == result.Result {
+		return WriteResult{}, nil
+	}
+
+	affected, err := result.Result.RowsAffected()
+
+	return WriteResult{RowsAffected: affected}, err
+}
@@ -0,0 +1,42 @@
+package goloquent
+
+// SubQuery is a struct for wrapping a query that is used as an expression of another query
+type SubQuery struct {
+	Query *Query
+	Alias string
+}
+
+func newSubQuery(query *Query, alias string) *SubQuery {
+	return &SubQuery{
+		Query: query,
+		Alias: alias,
+	}
+}
+
+// CTE is a struct for wrapping a common table expression, the recursive term is joined to the anchor term using UNION ALL
+type CTE struct {
+	Name      string
+	Query     *Query
+	Recursive *Query
+}
+
+func newCTE(name string, anchor *Query, recursive *Query) *CTE {
+	return &CTE{
+		Name:      name,
+		Query:     anchor,
+		Recursive: recursive,
+	}
+}
+
+// Compound is a struct for wrapping a query that is combined with the outer query using a set operation
+type Compound struct {
+	Operator SetOperator
+	Query    *Query
+}
+
+func newCompound(operator SetOperator, query *Query) *Compound {
+	return &Compound{
+		Operator: operator,
+		Query:    query,
+	}
+}
@@ -0,0 +1,92 @@
+package goloquent
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Span is a struct that is used to store information about a traced statement
+type Span struct {
+	Name      string
+	Statement Statement
+	StartedAt time.Time
+	EndedAt   time.Time
+	Err       error
+}
+
+// Duration is a function that returns the elapsed time of the span
+func (s *Span) Duration() time.Duration {
+	return s.EndedAt.Sub(s.StartedAt)
+}
+
+// SpanExporter is an interface used for receiving finished spans
+type SpanExporter interface {
+	Export(ctx context.Context, span *Span)
+}
+
+// InMemoryExporter is a SpanExporter that keeps every finished span in memory
+type InMemoryExporter struct {
+	mu    sync.Mutex
+	spans []*Span
+}
+
+// NewInMemoryExporter .
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{}
+}
+
+// Export .
+func (e *InMemoryExporter) Export(ctx context.Context, span *Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.spans = append(e.spans, span)
+}
+
+// Spans is a function that returns all exported spans
+func (e *InMemoryExporter) Spans() []*Span {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	spans := make([]*Span, len(e.spans))
+	copy(spans, e.spans)
+
+	return spans
+}
+
+// Reset is a function that removes all exported spans
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.spans = nil
+}
+
+// Tracing is an Interceptor that will create a span for every executed statement
+func Tracing(exporter SpanExporter) Interceptor {
+	return func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+		span := &Span{
+			Name:      spanName(stmt),
+			Statement: stmt,
+			StartedAt: time.Now(),
+		}
+
+		result, err := next(ctx, stmt)
+
+		span.EndedAt = time.Now()
+		span.Err = err
+
+		exporter.Export(ctx, span)
+
+		return result, err
+	}
+}
+
+func spanName(stmt Statement) string {
+	if "" == stmt.Table {
+		return string(stmt.Kind)
+	}
+
+	return string(stmt.Kind) + " " + stmt.Table
+}
@@ -0,0 +1,83 @@
+package goloquent
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// upsertRows is a function that answers the catalog lookup with unique and any inserted row with id 1
+func upsertRows(unique bool) fakeResponder {
+	return func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		switch {
+		case strings.Contains(query, "pg_index"):
+			return []string{"exists"}, [][]driver.Value{{unique}}
+		case strings.Contains(query, "pg_advisory_xact_lock"):
+			return []string{"bool"}, [][]driver.Value{{true}}
+		case strings.HasPrefix(query, "INSERT"):
+			return []string{"id", "name"}, [][]driver.Value{{int64(1), "drama"}}
+		}
+
+		return []string{"id", "name"}, nil
+	}
+}
+
+func TestUpsert_UpdateOrCreate(t *testing.T) {
+	t.Run("TestUpsert_LOCKED_INSERT", func(t *testing.T) {
+		db, fake := newFakeDB(t, upsertRows(false))
+
+		attrs := map[string]interface{}{"name": "drama"}
+
+		result, err := DB(db).Use(genreModel()).UpdateOrCreate(attrs, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), result.(*genre).ID)
+		require.Len(t, fake.Statements, 4)
+		require.Contains(t, fake.Statements[1], "pg_advisory_xact_lock")
+		require.Equal(t, []driver.Value{DB(db).Use(genreModel()).attributesKey(attrs)}, fake.Args[1])
+		require.Contains(t, fake.Statements[2], "FOR UPDATE")
+		require.True(t, strings.HasPrefix(fake.Statements[3], "INSERT"))
+	})
+
+	t.Run("TestUpsert_CACHED_CONSTRAINT", func(t *testing.T) {
+		db, fake := newFakeDB(t, upsertRows(true))
+
+		for i := 0; i < 2; i++ {
+			_, err := DB(db).Use(genreModel()).UpdateOrCreate(map[string]interface{}{"name": "drama"}, nil)
+
+			require.NoError(t, err)
+		}
+
+		require.Len(t, fake.Statements, 3)
+		require.Contains(t, fake.Statements[0], "to_regclass($1) AND i.indisunique AND i.indpred IS NULL AND i.indexprs IS NULL")
+		require.Contains(t, fake.Statements[0], `ORDER BY a.attname::TEXT COLLATE "C"`)
+		require.Contains(t, fake.Statements[1], "ON CONFLICT")
+		require.Contains(t, fake.Statements[2], "ON CONFLICT")
+	})
+
+	t.Run("TestUpsert_UUID_PRIMARY_KEY", func(t *testing.T) {
+		db, fake := newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+			if strings.Contains(query, "pg_index") {
+				return []string{"exists"}, [][]driver.Value{{true}}
+			}
+
+			return []string{"name"}, [][]driver.Value{{"alice"}}
+		})
+
+		result, err := DB(db).Use(&account{Model: UuidModel("accounts", "id", false, false)}).
+			UpdateOrCreate(map[string]interface{}{"name": "alice"}, nil)
+
+		require.NoError(t, err)
+		require.Len(t, result.(*account).ID, 36)
+		require.Contains(t, fake.Args[1], driver.Value(result.(*account).ID))
+	})
+}
+
+func TestUpsert_AttributesKey(t *testing.T) {
+	query := DB(nil).Use(genreModel())
+
+	require.Equal(t, AdvisoryKey("genres:name=drama"), query.attributesKey(map[string]interface{}{"name": "drama"}))
+	require.NotEqual(t, query.attributesKey(map[string]interface{}{"name": "drama"}), query.attributesKey(map[string]interface{}{"name": "comedy"}))
+}
@@ -0,0 +1,88 @@
+package goloquent
+
+import (
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UUID is a 128 bit universally unique identifier
+type UUID [16]byte
+
+// NewUUID is a function that will generate a random v4 UUID or a time ordered v7 UUID, v4 is used when the version is empty
+func NewUUID(version UuidVersion) (UUID, error) {
+	var uuid UUID
+
+	if _, err := rand.Read(uuid[:]); nil != err {
+		return uuid, err
+	}
+
+	switch version {
+	case UUID_V7:
+		ms := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+
+		var timestamp [8]byte
+		binary.BigEndian.PutUint64(timestamp[:], ms)
+		copy(uuid[0:6], timestamp[2:8])
+
+		uuid[6] = (uuid[6] & 0x0f) | 0x70
+	case UUID_V4, "":
+		uuid[6] = (uuid[6] & 0x0f) | 0x40
+	default:
+		return uuid, fmt.Errorf("unsupported uuid version %s", version)
+	}
+
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return uuid, nil
+}
+
+// String returns the canonical 36 characters representation of the UUID
+func (u UUID) String() string {
+	var buf [36]byte
+
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+
+	return string(buf[:])
+}
+
+// Value is a function that will convert the UUID into its string representation when written to the database
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// Scan is a function that will parse the UUID read from the database
+func (u *UUID) Scan(src interface{}) error {
+	var value string
+
+	switch v := src.(type) {
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into UUID", src)
+	}
+
+	decoded, err := hex.DecodeString(strings.Replace(value, "-", "", -1))
+
+	if nil != err || len(decoded) != len(u) {
+		return fmt.Errorf("invalid uuid %q", value)
+	}
+
+	copy(u[:], decoded)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package goloquent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type account struct {
+	Model
+	ID   string `db:"id"`
+	Name string `db:"name"`
+}
+
+func TestUUID_Generate(t *testing.T) {
+	t.Run("V4", func(t *testing.T) {
+		uuid, err := NewUUID(UUID_V4)
+
+		require.NoError(t, err)
+		require.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, uuid.String())
+	})
+
+	t.Run("V7", func(t *testing.T) {
+		first, err := NewUUID(UUID_V7)
+		require.NoError(t, err)
+
+		second, err := NewUUID(UUID_V7)
+		require.NoError(t, err)
+
+		require.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, first.String())
+		require.True(t, first.String()[:8] <= second.String()[:8])
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		uuid, _ := NewUUID(UUID_V4)
+
+		var scanned UUID
+
+		require.NoError(t, scanned.Scan(uuid.String()))
+		require.Equal(t, uuid, scanned)
+	})
+
+	t.Run("FillEmptyPrimaryKey", func(t *testing.T) {
+		model := &account{Model: UuidModel("accounts", "id", false, false)}
+
+		require.NoError(t, fillUuid(model))
+		require.Len(t, model.ID, 36)
+
+		id := model.ID
+
+		require.NoError(t, fillUuid(model))
+		require.Equal(t, id, model.ID)
+	})
+}
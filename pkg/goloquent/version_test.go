@@ -0,0 +1,177 @@
+package goloquent
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type versionedGenre struct {
+	Model
+	ID      int64  `db:"id"`
+	Name    string `db:"name"`
+	Version int64  `db:"version"`
+}
+
+func versionedGenreModel() *versionedGenre {
+	model := &versionedGenre{Model: AutoIncrementModel("genres", "id", false, false)}
+	model.Versioned = true
+
+	return model
+}
+
+type release struct {
+	Model
+	ID       int64 `db:"id"`
+	Revision int64 `db:"version"`
+}
+
+type document struct {
+	Model
+	ID          int64  `db:"id"`
+	Title       string `db:"title"`
+	LockVersion int64  `db:"lock_version"`
+}
+
+func TestVersion_Update(t *testing.T) {
+	affected := func(rows int64, statements *[]Statement) Interceptor {
+		return func(ctx context.Context, stmt Statement, next Handler) (Result, error) {
+			*statements = append(*statements, stmt)
+
+			return Result{Result: driver.RowsAffected(rows)}, nil
+		}
+	}
+
+	t.Run("IncrementVersion", func(t *testing.T) {
+		var statements []Statement
+
+		model := versionedGenreModel()
+		model.ID = 1
+		model.Version = 3
+		model.SyncOriginal(model)
+		model.Name = "Drama"
+
+		result, err := DB(nil).Use(model).Intercept(affected(1, &statements)).Update()
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), result.RowsAffected)
+		require.Equal(t, int64(4), model.Version)
+		require.Equal(t, `UPDATE genres SET "name"=:name, "version"=:version WHERE "id"=:id AND "version"=:old_version;`, statements[0].Query)
+		require.Equal(t, int64(3), statements[0].Args.(map[string]interface{})["old_version"])
+	})
+
+	t.Run("StaleObject", func(t *testing.T) {
+		var statements []Statement
+
+		model := versionedGenreModel()
+		model.ID = 1
+		model.Version = 3
+		model.SyncOriginal(model)
+		model.Name = "Drama"
+
+		result, err := DB(nil).Use(model).Intercept(affected(0, &statements)).Update()
+
+		require.Equal(t, ErrStaleObject, err)
+		require.Equal(t, int64(0), result.RowsAffected)
+		require.Equal(t, int64(3), model.Version)
+	})
+
+	t.Run("NotVersionedColumn", func(t *testing.T) {
+		var statements []Statement
+
+		model := &release{Model: AutoIncrementModel("releases", "id", false, false), ID: 1, Revision: 2}
+		model.SyncOriginal(model)
+		model.Revision = 3
+
+		result, err := DB(nil).Use(model).Intercept(affected(1, &statements)).Update()
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), result.RowsAffected)
+		require.Equal(t, `UPDATE releases SET "version"=:version WHERE "id"=:id;`, statements[0].Query)
+	})
+
+	t.Run("CustomColumn", func(t *testing.T) {
+		var statements []Statement
+
+		model := &document{Model: AutoIncrementModel("documents", "id", false, false), ID: 1, LockVersion: 7}
+		model.Versioned = true
+		model.VersionColumn = "lock_version"
+		model.SyncOriginal(model)
+		model.Title = "Draft"
+
+		_, err := DB(nil).Use(model).Intercept(affected(1, &statements)).Update()
+
+		require.NoError(t, err)
+		require.Equal(t, int64(8), model.LockVersion)
+		require.Equal(t, `UPDATE documents SET "title"=:title, "lock_version"=:lock_version WHERE "id"=:id AND "lock_version"=:old_lock_version;`, statements[0].Query)
+		require.Equal(t, int64(7), statements[0].Args.(map[string]interface{})["old_lock_version"])
+	})
+
+	t.Run("MissingColumn", func(t *testing.T) {
+		model := genreModel()
+		model.Versioned = true
+		model.SyncOriginal(model)
+		model.Name = "Drama"
+
+		_, err := DB(nil).Use(model).Update()
+
+		require.Error(t, err)
+	})
+}
+
+func TestVersion_Load(t *testing.T) {
+	respond := func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		if strings.HasPrefix(query, "UPDATE") {
+			return nil, [][]driver.Value{{}}
+		}
+
+		return []string{"id", "name", "version"}, [][]driver.Value{{int64(1), "Action", int64(3)}}
+	}
+
+	t.Run("LoadedUpdate", func(t *testing.T) {
+		db, fake := newFakeDB(t, respond)
+
+		result, err := DB(db).Use(versionedGenreModel()).Find(1)
+
+		require.NoError(t, err)
+
+		model := result.(*versionedGenre)
+
+		require.Equal(t, int64(3), model.Version)
+
+		model.Name = "Drama"
+
+		written, err := DB(db).Use(model).Update()
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), written.RowsAffected)
+		require.Equal(t, int64(4), model.Version)
+		require.Equal(t, `UPDATE genres SET "name"=$1, "version"=$2 WHERE "id"=$3 AND "version"=$4;`, fake.Statements[1])
+		require.Equal(t, []driver.Value{"Drama", int64(4), int64(1), int64(3)}, fake.Args[1])
+	})
+
+	t.Run("NotVersionedColumn", func(t *testing.T) {
+		db, _ := newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+			return []string{"id", "version"}, [][]driver.Value{{int64(1), int64(2)}}
+		})
+
+		result, err := DB(db).Use(&release{Model: AutoIncrementModel("releases", "id", false, false)}).Find(1)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(2), result.(*release).Revision)
+	})
+}
+
+func TestVersion_Upsert(t *testing.T) {
+	model := versionedGenreModel()
+	model.Name = "Drama"
+	model.Version = 1
+
+	expectedQuery := `INSERT INTO genres ("name", "version") VALUES (:name, :version) ON CONFLICT ("name") DO UPDATE SET "name"=EXCLUDED."name", "version"="genres"."version"+1 RETURNING "id", "name", "version";
+`
+
+	require.Equal(t, expectedQuery, NewBuilder().BuildUpsert(model, []string{"name"}, []string{"name", "version"}))
+}
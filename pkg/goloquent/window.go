@@ -0,0 +1,87 @@
+package goloquent
+
+// Window is a struct for wrapping the OVER clause of a window function
+type Window struct {
+	PartitionBy []string
+	Orders      []*Order
+}
+
+// WindowFunction is a struct for wrapping a window function select column
+type WindowFunction struct {
+	Function  string
+	Column    string
+	Offset    int
+	Aggregate *Aggregate
+	Window    *Window
+	Alias     string
+}
+
+// PartitionBy is a function that will create a window partitioned by the given columns
+func PartitionBy(columns ...string) *Window {
+	return &Window{
+		PartitionBy: columns,
+	}
+}
+
+// OrderBy method adds an ordering to the window
+func (w *Window) OrderBy(column string, direction OrderDirection) *Window {
+	w.Orders = append(w.Orders, newOrder(direction, column))
+
+	return w
+}
+
+// RowNumber is a function that will create a ROW_NUMBER window function
+func RowNumber() *WindowFunction {
+	return newWindowFunction(ROW_NUMBER, "", 0)
+}
+
+// Rank is a function that will create a RANK window function
+func Rank() *WindowFunction {
+	return newWindowFunction(RANK, "", 0)
+}
+
+// DenseRank is a function that will create a DENSE_RANK window function
+func DenseRank() *WindowFunction {
+	return newWindowFunction(DENSE_RANK, "", 0)
+}
+
+// Lag is a function that will create a LAG window function reading the column offset rows before the current one
+func Lag(column string, offset int) *WindowFunction {
+	return newWindowFunction(LAG, column, offset)
+}
+
+// Lead is a function that will create a LEAD window function reading the column offset rows after the current one
+func Lead(column string, offset int) *WindowFunction {
+	return newWindowFunction(LEAD, column, offset)
+}
+
+// Over method computes the aggregate over a window instead of collapsing the rows
+func (a *Aggregate) Over(window *Window) *WindowFunction {
+	return &WindowFunction{
+		Aggregate: a,
+		Window:    window,
+		Alias:     a.Alias,
+	}
+}
+
+// Over method sets the window of the function
+func (f *WindowFunction) Over(window *Window) *WindowFunction {
+	f.Window = window
+
+	return f
+}
+
+// As method sets the alias of the window function result column
+func (f *WindowFunction) As(alias string) *WindowFunction {
+	f.Alias = alias
+
+	return f
+}
+
+func newWindowFunction(function WindowFunc, column string, offset int) *WindowFunction {
+	return &WindowFunction{
+		Function: string(function),
+		Column:   column,
+		Offset:   offset,
+	}
+}